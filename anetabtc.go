@@ -0,0 +1,139 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// AnetaBtcMintRequestDatum represents a request to mint cBTC against a BTC deposit, as held by
+// the AnetaBTC minting contract while awaiting guardian approval
+type AnetaBtcMintRequestDatum struct {
+	// Reference to the BTC deposit output
+	BtcTxId        []byte
+	BtcOutputIndex int64
+	AmountSats     int64
+	// Recipient is the address that receives the minted cBTC
+	Recipient PlutusAddress
+	// Approvals is the list of guardian public key hashes that have approved the request
+	Approvals [][]byte
+}
+
+func (a *AnetaBtcMintRequestDatum) MarshalCBOR() ([]byte, error) {
+	var approvals any = []any{}
+	if len(a.Approvals) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(a.Approvals))
+		for _, approval := range a.Approvals {
+			tmpList = append(tmpList, approval)
+		}
+		approvals = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			a.BtcTxId,
+			a.BtcOutputIndex,
+			a.AmountSats,
+			&a.Recipient,
+			approvals,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (a *AnetaBtcMintRequestDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 5)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(
+		fields,
+		&a.BtcTxId,
+		&a.BtcOutputIndex,
+		&a.AmountSats,
+		&a.Recipient,
+		&a.Approvals,
+	)
+}
+
+// IsApproved returns true if the request has been approved by enough distinct guardians from
+// the provided guardian configuration
+func (a AnetaBtcMintRequestDatum) IsApproved(config AnetaBtcGuardianConfigDatum) bool {
+	var approvals int64
+	for idx, approval := range a.Approvals {
+		// Ignore duplicate approvals
+		duplicate := false
+		for _, prevApproval := range a.Approvals[:idx] {
+			if bytes.Equal(approval, prevApproval) {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+		for _, guardian := range config.Guardians {
+			if bytes.Equal(approval, guardian) {
+				approvals++
+				break
+			}
+		}
+	}
+	return approvals >= config.Threshold
+}
+
+// AnetaBtcGuardianConfigDatum represents the guardian set that approves AnetaBTC mint requests
+type AnetaBtcGuardianConfigDatum struct {
+	// Guardians is the list of guardian public key hashes
+	Guardians [][]byte
+	// Threshold is the number of guardian approvals required to mint
+	Threshold int64
+}
+
+func (a *AnetaBtcGuardianConfigDatum) MarshalCBOR() ([]byte, error) {
+	var guardians any = []any{}
+	if len(a.Guardians) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(a.Guardians))
+		for _, guardian := range a.Guardians {
+			tmpList = append(tmpList, guardian)
+		}
+		guardians = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			guardians,
+			a.Threshold,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (a *AnetaBtcGuardianConfigDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &a.Guardians, &a.Threshold)
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"bytes"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+var testAnetaBtcGuardians = [][]byte{
+	bytes.Repeat([]byte{0x01}, 28),
+	bytes.Repeat([]byte{0x02}, 28),
+	bytes.Repeat([]byte{0x03}, 28),
+}
+
+func TestAnetaBtcMintRequestDatumDecodeEncode(t *testing.T) {
+	btcTxId := decodeHex("4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b")
+	config := models.AnetaBtcGuardianConfigDatum{
+		Guardians: testAnetaBtcGuardians,
+		Threshold: 2,
+	}
+	testDefs := []struct {
+		cborHex          string
+		expectedObj      models.AnetaBtcMintRequestDatum
+		expectedApproved bool
+	}{
+		{
+			cborHex: "d8799f58204a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b011a000249f0" + testPlutusAddressBaseHex + "9f581c01010101010101010101010101010101010101010101010101010101581c03030303030303030303030303030303030303030303030303030303ffff",
+			expectedObj: models.AnetaBtcMintRequestDatum{
+				BtcTxId:        btcTxId,
+				BtcOutputIndex: 1,
+				AmountSats:     150000,
+				Recipient:      testPlutusAddressBase,
+				Approvals: [][]byte{
+					testAnetaBtcGuardians[0],
+					testAnetaBtcGuardians[2],
+				},
+			},
+			expectedApproved: true,
+		},
+		{
+			// No approvals yet
+			cborHex: "d8799f58204a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b001a000249f0" + testPlutusAddressBaseHex + "80ff",
+			expectedObj: models.AnetaBtcMintRequestDatum{
+				BtcTxId:    btcTxId,
+				AmountSats: 150000,
+				Recipient:  testPlutusAddressBase,
+				Approvals:  [][]byte{},
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.AnetaBtcMintRequestDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+		if testObj.IsApproved(config) != testDef.expectedApproved {
+			t.Fatalf("did not get expected approval status: got %v", testObj.IsApproved(config))
+		}
+	}
+}
+
+func TestAnetaBtcMintRequestDuplicateApprovals(t *testing.T) {
+	config := models.AnetaBtcGuardianConfigDatum{
+		Guardians: testAnetaBtcGuardians,
+		Threshold: 2,
+	}
+	datum := models.AnetaBtcMintRequestDatum{
+		Approvals: [][]byte{
+			testAnetaBtcGuardians[1],
+			testAnetaBtcGuardians[1],
+			bytes.Repeat([]byte{0x04}, 28),
+		},
+	}
+	if datum.IsApproved(config) {
+		t.Fatalf("expected request with duplicate and unknown approvals to not be approved")
+	}
+}
+
+func TestAnetaBtcGuardianConfigDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799f9f581c01010101010101010101010101010101010101010101010101010101581c02020202020202020202020202020202020202020202020202020202581c03030303030303030303030303030303030303030303030303030303ff02ff"
+	expectedObj := models.AnetaBtcGuardianConfigDatum{
+		Guardians: testAnetaBtcGuardians,
+		Threshold: 2,
+	}
+	var testObj models.AnetaBtcGuardianConfigDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+}
@@ -15,13 +15,76 @@
 package models
 
 import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/miekg/dns"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/net/idna"
 )
 
 type CardanoDnsTtl uint
 
+// Duration returns the TTL as a time.Duration
+func (t CardanoDnsTtl) Duration() time.Duration {
+	return time.Duration(t) * time.Second
+}
+
+// Validate checks that the TTL fits in the 32-bit TTL field used by DNS
+func (t CardanoDnsTtl) Validate() error {
+	if uint64(t) > math.MaxUint32 {
+		return fmt.Errorf("TTL out of range: %d", t)
+	}
+	return nil
+}
+
+// CardanoDnsRecordType identifies the type of a DNS record. The values match the standard
+// uppercase DNS record type names
+type CardanoDnsRecordType string
+
+const (
+	CardanoDnsRecordTypeA     CardanoDnsRecordType = "A"
+	CardanoDnsRecordTypeAAAA  CardanoDnsRecordType = "AAAA"
+	CardanoDnsRecordTypeNS    CardanoDnsRecordType = "NS"
+	CardanoDnsRecordTypeCNAME CardanoDnsRecordType = "CNAME"
+	CardanoDnsRecordTypeMX    CardanoDnsRecordType = "MX"
+	CardanoDnsRecordTypeTXT   CardanoDnsRecordType = "TXT"
+	CardanoDnsRecordTypeSRV   CardanoDnsRecordType = "SRV"
+	CardanoDnsRecordTypeSOA   CardanoDnsRecordType = "SOA"
+)
+
+// ParseCardanoDnsRecordType returns the record type for the specified name, ignoring case
+func ParseCardanoDnsRecordType(name string) (CardanoDnsRecordType, error) {
+	recordType := CardanoDnsRecordType(strings.ToUpper(name))
+	switch recordType {
+	case CardanoDnsRecordTypeA,
+		CardanoDnsRecordTypeAAAA,
+		CardanoDnsRecordTypeNS,
+		CardanoDnsRecordTypeCNAME,
+		CardanoDnsRecordTypeMX,
+		CardanoDnsRecordTypeTXT,
+		CardanoDnsRecordTypeSRV,
+		CardanoDnsRecordTypeSOA:
+		return recordType, nil
+	default:
+		return "", fmt.Errorf("unsupported DNS record type: %s", name)
+	}
+}
+
 type CardanoDnsDomain struct {
 	// This allows the type to be used with cbor.DecodeGeneric
 	cbor.StructAsArray
@@ -43,21 +106,150 @@ func (c CardanoDnsDomain) String() string {
 			ret += ", "
 		}
 	}
-	ret += "] }"
+	ret += fmt.Sprintf("], AdditionalData = %s }", c.AdditionalData)
+	return ret
+}
+
+// GoString returns a representation of the domain as a Go expression, for use with %#v
+func (c CardanoDnsDomain) GoString() string {
+	ret := fmt.Sprintf("models.CardanoDnsDomain{Origin: []byte(%q), Records: []models.CardanoDnsDomainRecord{", c.Origin)
+	for idx, record := range c.Records {
+		if idx > 0 {
+			ret += ", "
+		}
+		ret += record.GoString()
+	}
+	ret += fmt.Sprintf("}, AdditionalData: %#v}", c.AdditionalData)
 	return ret
 }
 
+// LogValue summarizes the domain for structured logging
+func (c CardanoDnsDomain) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("origin", string(c.Origin)),
+		slog.Int("records", len(c.Records)),
+	)
+}
+
+// Validate checks that the domain's records have supported types and that their names and
+// values are valid for their type
+func (c CardanoDnsDomain) Validate() error {
+	for idx, record := range c.Records {
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("record %d: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+// Merge adds the records from another datum for the same domain, such as when a domain's records
+// are split across multiple UTxOs. Duplicate records are removed as with Dedupe. CNAME and SOA
+// records may only occur once per name, so differing records of those types with the same name
+// are a conflict and return an error. The existing additional data is kept if present
+func (c *CardanoDnsDomain) Merge(other CardanoDnsDomain) error {
+	if cardanoDnsNameKey(c.Origin) != cardanoDnsNameKey(other.Origin) {
+		return fmt.Errorf("cannot merge domains with different origins: %s, %s", c.Origin, other.Origin)
+	}
+	for _, record := range other.Records {
+		if !record.isSingletonType() {
+			continue
+		}
+		for _, existing := range c.Records {
+			if existing.sameNameAndType(record) && !existing.sameValue(record) {
+				return fmt.Errorf("conflicting %s records for name %s", record.Type, record.Lhs)
+			}
+		}
+	}
+	c.Records = append(c.Records, other.Records...)
+	if !c.AdditionalData.HasValue() {
+		c.AdditionalData = other.AdditionalData
+	}
+	c.Dedupe()
+	return nil
+}
+
+// Dedupe removes duplicate records, keeping the first occurrence. Records are duplicates when
+// they have the same name, type and value, with names and types compared case-insensitively.
+// The TTL is not considered, so the TTL of the first occurrence is kept
+func (c *CardanoDnsDomain) Dedupe() {
+	ret := make([]CardanoDnsDomainRecord, 0, len(c.Records))
+	for _, record := range c.Records {
+		duplicate := false
+		for _, prevRecord := range ret {
+			if prevRecord.sameNameAndType(record) && prevRecord.sameValue(record) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			ret = append(ret, record)
+		}
+	}
+	c.Records = ret
+}
+
+func (c *CardanoDnsDomain) MarshalCBOR() ([]byte, error) {
+	var records any = []any{}
+	if len(c.Records) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(c.Records))
+		for idx := range c.Records {
+			tmpList = append(tmpList, &c.Records[idx])
+		}
+		records = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		1,
+		cbor.IndefLengthList{
+			c.Origin,
+			records,
+			&c.AdditionalData,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
 func (c *CardanoDnsDomain) UnmarshalCBOR(cborData []byte) error {
 	var tmpData cbor.Constructor
 	if _, err := cbor.Decode(cborData, &tmpData); err != nil {
 		return err
 	}
 	if tmpData.Constructor() != 1 {
-		return fmt.Errorf("unexpected constructor index: %d", tmpData.Constructor())
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, tmpData.Constructor())
 	}
 	return cbor.DecodeGeneric(tmpData.FieldsCbor(), c)
 }
 
+type cardanoDnsDomainJson struct {
+	Origin         string                   `json:"origin"`
+	Records        []CardanoDnsDomainRecord `json:"records"`
+	AdditionalData any                      `json:"additionalData,omitempty"`
+}
+
+func (c CardanoDnsDomain) MarshalJSON() ([]byte, error) {
+	tmp := cardanoDnsDomainJson{
+		Origin:  string(c.Origin),
+		Records: c.Records,
+	}
+	if tmp.Records == nil {
+		tmp.Records = []CardanoDnsDomainRecord{}
+	}
+	if c.AdditionalData.HasValue() {
+		tmp.AdditionalData = metadatumToJson(c.AdditionalData.Value)
+	}
+	return json.Marshal(&tmp)
+}
+
+func (c *CardanoDnsDomain) UnmarshalJSON(data []byte) error {
+	var tmp cardanoDnsDomainJson
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	c.Origin = []byte(tmp.Origin)
+	c.Records = tmp.Records
+	c.AdditionalData = NewCardanoDnsMaybe[any](tmp.AdditionalData)
+	return nil
+}
+
 type CardanoDnsDomainRecord struct {
 	// This allows the type to be used with cbor.DecodeGeneric
 	cbor.StructAsArray
@@ -67,22 +259,396 @@ type CardanoDnsDomainRecord struct {
 	Rhs  []byte
 }
 
+func (c *CardanoDnsDomainRecord) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		1,
+		cbor.IndefLengthList{
+			c.Lhs,
+			&c.Ttl,
+			c.Type,
+			c.Rhs,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
 func (c *CardanoDnsDomainRecord) UnmarshalCBOR(data []byte) error {
 	var tmpConstr cbor.Constructor
 	if _, err := cbor.Decode(data, &tmpConstr); err != nil {
 		return err
 	}
 	if tmpConstr.Constructor() != 1 {
-		return fmt.Errorf("unexpected constructor index: %d", tmpConstr.Constructor())
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, tmpConstr.Constructor())
 	}
 	return cbor.DecodeGeneric(tmpConstr.FieldsCbor(), c)
 }
 
+// RecordType returns the parsed type of the record
+func (c CardanoDnsDomainRecord) RecordType() (CardanoDnsRecordType, error) {
+	return ParseCardanoDnsRecordType(string(c.Type))
+}
+
+// Validate checks that the record has a supported type and that its name and value are valid
+// for the type
+func (c CardanoDnsDomainRecord) Validate() error {
+	recordType, err := c.RecordType()
+	if err != nil {
+		return err
+	}
+	if err := validateCardanoDnsName(string(c.Lhs)); err != nil {
+		return err
+	}
+	if c.Ttl.HasValue() {
+		if err := c.Ttl.Value.Validate(); err != nil {
+			return err
+		}
+	}
+	rhs := string(c.Rhs)
+	switch recordType {
+	case CardanoDnsRecordTypeA:
+		addr, err := netip.ParseAddr(rhs)
+		if err != nil || !addr.Is4() {
+			return fmt.Errorf("invalid IPv4 address for A record: %s", rhs)
+		}
+	case CardanoDnsRecordTypeAAAA:
+		addr, err := netip.ParseAddr(rhs)
+		if err != nil || !addr.Is6() {
+			return fmt.Errorf("invalid IPv6 address for AAAA record: %s", rhs)
+		}
+	case CardanoDnsRecordTypeNS, CardanoDnsRecordTypeCNAME:
+		return validateCardanoDnsName(rhs)
+	case CardanoDnsRecordTypeMX:
+		if _, err := c.MxData(); err != nil {
+			return err
+		}
+	case CardanoDnsRecordTypeTXT:
+		if len(c.Rhs) == 0 {
+			return errors.New("empty TXT record value")
+		}
+	case CardanoDnsRecordTypeSRV:
+		if _, err := c.SrvData(); err != nil {
+			return err
+		}
+	case CardanoDnsRecordTypeSOA:
+		if _, err := c.SoaData(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CardanoDnsMxData represents the value of an MX record
+type CardanoDnsMxData struct {
+	Preference uint16
+	Exchange   string
+}
+
+func (d CardanoDnsMxData) String() string {
+	return fmt.Sprintf("%d %s", d.Preference, d.Exchange)
+}
+
+// CardanoDnsSrvData represents the value of an SRV record
+type CardanoDnsSrvData struct {
+	Priority uint16
+	Weight   uint16
+	Port     uint16
+	Target   string
+}
+
+func (d CardanoDnsSrvData) String() string {
+	return fmt.Sprintf("%d %d %d %s", d.Priority, d.Weight, d.Port, d.Target)
+}
+
+// CardanoDnsSoaData represents the value of an SOA record
+type CardanoDnsSoaData struct {
+	Mname   string
+	Rname   string
+	Serial  uint32
+	Refresh uint32
+	Retry   uint32
+	Expire  uint32
+	Minimum uint32
+}
+
+func (d CardanoDnsSoaData) String() string {
+	return fmt.Sprintf(
+		"%s %s %d %d %d %d %d",
+		d.Mname,
+		d.Rname,
+		d.Serial,
+		d.Refresh,
+		d.Retry,
+		d.Expire,
+		d.Minimum,
+	)
+}
+
+// MxData parses the value of an MX record, which has the form "<preference> <exchange>"
+func (c CardanoDnsDomainRecord) MxData() (*CardanoDnsMxData, error) {
+	fields, err := c.rhsFields(CardanoDnsRecordTypeMX, 2)
+	if err != nil {
+		return nil, err
+	}
+	preference, err := parseCardanoDnsUint(fields[0], 16)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCardanoDnsName(fields[1]); err != nil {
+		return nil, err
+	}
+	return &CardanoDnsMxData{
+		Preference: uint16(preference),
+		Exchange:   fields[1],
+	}, nil
+}
+
+// SetMxData sets the record type to MX and encodes the value from the provided data
+func (c *CardanoDnsDomainRecord) SetMxData(data CardanoDnsMxData) {
+	c.Type = []byte(CardanoDnsRecordTypeMX)
+	c.Rhs = []byte(data.String())
+}
+
+// SrvData parses the value of an SRV record, which has the form
+// "<priority> <weight> <port> <target>"
+func (c CardanoDnsDomainRecord) SrvData() (*CardanoDnsSrvData, error) {
+	fields, err := c.rhsFields(CardanoDnsRecordTypeSRV, 4)
+	if err != nil {
+		return nil, err
+	}
+	var vals [3]uint16
+	for idx := range vals {
+		val, err := parseCardanoDnsUint(fields[idx], 16)
+		if err != nil {
+			return nil, err
+		}
+		vals[idx] = uint16(val)
+	}
+	if err := validateCardanoDnsName(fields[3]); err != nil {
+		return nil, err
+	}
+	return &CardanoDnsSrvData{
+		Priority: vals[0],
+		Weight:   vals[1],
+		Port:     vals[2],
+		Target:   fields[3],
+	}, nil
+}
+
+// SetSrvData sets the record type to SRV and encodes the value from the provided data
+func (c *CardanoDnsDomainRecord) SetSrvData(data CardanoDnsSrvData) {
+	c.Type = []byte(CardanoDnsRecordTypeSRV)
+	c.Rhs = []byte(data.String())
+}
+
+// SoaData parses the value of an SOA record, which has the form
+// "<mname> <rname> <serial> <refresh> <retry> <expire> <minimum>"
+func (c CardanoDnsDomainRecord) SoaData() (*CardanoDnsSoaData, error) {
+	fields, err := c.rhsFields(CardanoDnsRecordTypeSOA, 7)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range fields[:2] {
+		if err := validateCardanoDnsName(field); err != nil {
+			return nil, err
+		}
+	}
+	var vals [5]uint32
+	for idx := range vals {
+		val, err := parseCardanoDnsUint(fields[idx+2], 32)
+		if err != nil {
+			return nil, err
+		}
+		vals[idx] = uint32(val)
+	}
+	return &CardanoDnsSoaData{
+		Mname:   fields[0],
+		Rname:   fields[1],
+		Serial:  vals[0],
+		Refresh: vals[1],
+		Retry:   vals[2],
+		Expire:  vals[3],
+		Minimum: vals[4],
+	}, nil
+}
+
+// SetSoaData sets the record type to SOA and encodes the value from the provided data
+func (c *CardanoDnsDomainRecord) SetSoaData(data CardanoDnsSoaData) {
+	c.Type = []byte(CardanoDnsRecordTypeSOA)
+	c.Rhs = []byte(data.String())
+}
+
+// rhsFields checks the record type and splits the value into the expected number of fields
+func (c CardanoDnsDomainRecord) rhsFields(
+	expectedType CardanoDnsRecordType,
+	numFields int,
+) ([]string, error) {
+	recordType, err := c.RecordType()
+	if err != nil {
+		return nil, err
+	}
+	if recordType != expectedType {
+		return nil, fmt.Errorf("record type is %s, not %s", recordType, expectedType)
+	}
+	fields := strings.Fields(string(c.Rhs))
+	if len(fields) != numFields {
+		return nil, fmt.Errorf("invalid %s record value: %s", recordType, c.Rhs)
+	}
+	return fields, nil
+}
+
+// validateCardanoDnsName checks that a domain name is made up of valid DNS labels
+func validateCardanoDnsName(name string) error {
+	name = strings.TrimSuffix(name, ".")
+	if len(name) == 0 || len(name) > 253 {
+		return fmt.Errorf("invalid DNS name length: %q", name)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return fmt.Errorf("invalid DNS label length in name: %q", name)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("invalid DNS label in name: %q", name)
+		}
+		for _, ch := range label {
+			if (ch < 'a' || ch > 'z') && (ch < 'A' || ch > 'Z') &&
+				(ch < '0' || ch > '9') && ch != '-' && ch != '_' {
+				return fmt.Errorf("invalid character in DNS name: %q", name)
+			}
+		}
+	}
+	return nil
+}
+
+// IDNA profile used for name normalization. This is the lookup profile, but allowing underscores
+// for names such as SRV records
+var cardanoDnsIdnaProfile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.StrictDomainName(false),
+)
+
+// NormalizeCardanoDnsName returns the canonical form of a DNS name: lowercase, without a trailing
+// dot, and with internationalized labels converted to punycode. The result is validated to
+// contain only legal DNS labels
+func NormalizeCardanoDnsName(name string) (string, error) {
+	ret, err := cardanoDnsIdnaProfile.ToASCII(strings.TrimSuffix(name, "."))
+	if err != nil {
+		return "", fmt.Errorf("invalid DNS name %q: %w", name, err)
+	}
+	if err := validateCardanoDnsName(ret); err != nil {
+		return "", err
+	}
+	return ret, nil
+}
+
+// Normalize normalizes the domain origin and all record names in place
+func (c *CardanoDnsDomain) Normalize() error {
+	origin, err := NormalizeCardanoDnsName(string(c.Origin))
+	if err != nil {
+		return err
+	}
+	c.Origin = []byte(origin)
+	for idx := range c.Records {
+		if err := c.Records[idx].Normalize(); err != nil {
+			return fmt.Errorf("record %d: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+// Normalize normalizes the record name in place
+func (c *CardanoDnsDomainRecord) Normalize() error {
+	lhs, err := NormalizeCardanoDnsName(string(c.Lhs))
+	if err != nil {
+		return err
+	}
+	c.Lhs = []byte(lhs)
+	return nil
+}
+
+// cardanoDnsNameKey returns a DNS name in a form suitable for case-insensitive comparison
+func cardanoDnsNameKey(name []byte) string {
+	return strings.ToLower(strings.TrimSuffix(string(name), "."))
+}
+
+func (c CardanoDnsDomainRecord) sameNameAndType(other CardanoDnsDomainRecord) bool {
+	return cardanoDnsNameKey(c.Lhs) == cardanoDnsNameKey(other.Lhs) &&
+		strings.EqualFold(string(c.Type), string(other.Type))
+}
+
+func (c CardanoDnsDomainRecord) sameValue(other CardanoDnsDomainRecord) bool {
+	return bytes.Equal(c.Rhs, other.Rhs)
+}
+
+// isSingletonType returns true for record types that may only occur once per name
+func (c CardanoDnsDomainRecord) isSingletonType() bool {
+	recordType, err := c.RecordType()
+	if err != nil {
+		return false
+	}
+	return recordType == CardanoDnsRecordTypeCNAME || recordType == CardanoDnsRecordTypeSOA
+}
+
+// parseCardanoDnsUint parses a record value field as an unsigned integer that fits in the
+// specified number of bits
+func parseCardanoDnsUint(val string, bitSize int) (uint64, error) {
+	ret, err := strconv.ParseUint(val, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid numeric field in record value: %s", val)
+	}
+	return ret, nil
+}
+
+type cardanoDnsDomainRecordJson struct {
+	Lhs  string         `json:"lhs"`
+	Ttl  *CardanoDnsTtl `json:"ttl,omitempty"`
+	Type string         `json:"type"`
+	Rhs  string         `json:"rhs"`
+}
+
+func (c CardanoDnsDomainRecord) MarshalJSON() ([]byte, error) {
+	tmp := cardanoDnsDomainRecordJson{
+		Lhs:  string(c.Lhs),
+		Type: string(c.Type),
+		Rhs:  string(c.Rhs),
+	}
+	if c.Ttl.HasValue() {
+		tmp.Ttl = &c.Ttl.Value
+	}
+	return json.Marshal(&tmp)
+}
+
+func (c *CardanoDnsDomainRecord) UnmarshalJSON(data []byte) error {
+	var tmp cardanoDnsDomainRecordJson
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	c.Lhs = []byte(tmp.Lhs)
+	c.Type = []byte(tmp.Type)
+	c.Rhs = []byte(tmp.Rhs)
+	c.Ttl = CardanoDnsMaybe[CardanoDnsTtl]{}
+	if tmp.Ttl != nil {
+		c.Ttl = NewCardanoDnsMaybe[CardanoDnsTtl](*tmp.Ttl)
+	}
+	return nil
+}
+
 func (c CardanoDnsDomainRecord) String() string {
 	return fmt.Sprintf(
-		"CardanoDnsDomainRecord { Lhs = %s, Ttl = %d, Type = %s, Rhs = %s }",
+		"CardanoDnsDomainRecord { Lhs = %s, Ttl = %s, Type = %s, Rhs = %s }",
 		c.Lhs,
-		c.Ttl.Value,
+		c.Ttl,
+		c.Type,
+		c.Rhs,
+	)
+}
+
+// GoString returns a representation of the record as a Go expression, for use with %#v
+func (c CardanoDnsDomainRecord) GoString() string {
+	return fmt.Sprintf(
+		"models.CardanoDnsDomainRecord{Lhs: []byte(%q), Ttl: %#v, Type: []byte(%q), Rhs: []byte(%q)}",
+		c.Lhs,
+		c.Ttl,
 		c.Type,
 		c.Rhs,
 	)
@@ -109,6 +675,33 @@ func (c CardanoDnsMaybe[T]) HasValue() bool {
 	return c.hasValue
 }
 
+// String returns "None" for an absent value, or "Some(x)" for a present value x
+func (c CardanoDnsMaybe[T]) String() string {
+	if !c.hasValue {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", c.Value)
+}
+
+// GoString returns a representation of the value as a Go expression, for use with %#v
+func (c CardanoDnsMaybe[T]) GoString() string {
+	typeName := reflect.TypeOf((*T)(nil)).Elem().String()
+	if !c.hasValue {
+		return fmt.Sprintf("models.NewCardanoDnsMaybe[%s](nil)", typeName)
+	}
+	return fmt.Sprintf("models.NewCardanoDnsMaybe[%s](%#v)", typeName, c.Value)
+}
+
+func (c *CardanoDnsMaybe[T]) MarshalCBOR() ([]byte, error) {
+	var tmp cbor.Constructor
+	if c.hasValue {
+		tmp = encodePlutusMaybe(&c.Value)
+	} else {
+		tmp = encodePlutusMaybe[T](nil)
+	}
+	return cbor.Encode(&tmp)
+}
+
 func (c *CardanoDnsMaybe[T]) UnmarshalCBOR(data []byte) error {
 	var tmpConstr cbor.Constructor
 	if _, err := cbor.Decode(data, &tmpConstr); err != nil {
@@ -122,3 +715,542 @@ func (c *CardanoDnsMaybe[T]) UnmarshalCBOR(data []byte) error {
 	}
 	return nil
 }
+
+// Maximum length of a single character string in a DNS TXT record
+const cardanoDnsTxtChunkSize = 255
+
+// TtlOrDefault returns the record TTL, or the provided default if the record has no TTL
+func (c CardanoDnsDomainRecord) TtlOrDefault(def CardanoDnsTtl) CardanoDnsTtl {
+	if c.Ttl.HasValue() {
+		return c.Ttl.Value
+	}
+	return def
+}
+
+// ToRR converts the record to a resource record for use with github.com/miekg/dns. Records
+// without a TTL use a TTL of 0
+func (c CardanoDnsDomainRecord) ToRR() (dns.RR, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	// The record type has already been validated above
+	recordType, _ := c.RecordType()
+	hdr := dns.RR_Header{
+		Name:   dns.Fqdn(string(c.Lhs)),
+		Rrtype: dns.StringToType[string(recordType)],
+		Class:  dns.ClassINET,
+	}
+	hdr.Ttl = uint32(c.TtlOrDefault(0))
+	rhs := string(c.Rhs)
+	switch recordType {
+	case CardanoDnsRecordTypeA, CardanoDnsRecordTypeAAAA:
+		addr, err := netip.ParseAddr(rhs)
+		if err != nil {
+			return nil, err
+		}
+		if recordType == CardanoDnsRecordTypeA {
+			return &dns.A{Hdr: hdr, A: net.IP(addr.AsSlice())}, nil
+		}
+		return &dns.AAAA{Hdr: hdr, AAAA: net.IP(addr.AsSlice())}, nil
+	case CardanoDnsRecordTypeNS:
+		return &dns.NS{Hdr: hdr, Ns: dns.Fqdn(rhs)}, nil
+	case CardanoDnsRecordTypeCNAME:
+		return &dns.CNAME{Hdr: hdr, Target: dns.Fqdn(rhs)}, nil
+	case CardanoDnsRecordTypeMX:
+		data, err := c.MxData()
+		if err != nil {
+			return nil, err
+		}
+		return &dns.MX{
+			Hdr:        hdr,
+			Preference: data.Preference,
+			Mx:         dns.Fqdn(data.Exchange),
+		}, nil
+	case CardanoDnsRecordTypeTXT:
+		// TXT records are made up of character strings of at most 255 bytes
+		var txt []string
+		for len(rhs) > 0 {
+			end := min(len(rhs), cardanoDnsTxtChunkSize)
+			txt = append(txt, rhs[:end])
+			rhs = rhs[end:]
+		}
+		return &dns.TXT{Hdr: hdr, Txt: txt}, nil
+	case CardanoDnsRecordTypeSRV:
+		data, err := c.SrvData()
+		if err != nil {
+			return nil, err
+		}
+		return &dns.SRV{
+			Hdr:      hdr,
+			Priority: data.Priority,
+			Weight:   data.Weight,
+			Port:     data.Port,
+			Target:   dns.Fqdn(data.Target),
+		}, nil
+	case CardanoDnsRecordTypeSOA:
+		data, err := c.SoaData()
+		if err != nil {
+			return nil, err
+		}
+		return &dns.SOA{
+			Hdr:     hdr,
+			Ns:      dns.Fqdn(data.Mname),
+			Mbox:    dns.Fqdn(data.Rname),
+			Serial:  data.Serial,
+			Refresh: data.Refresh,
+			Retry:   data.Retry,
+			Expire:  data.Expire,
+			Minttl:  data.Minimum,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported DNS record type: %s", recordType)
+	}
+}
+
+// ToRRSet converts all of the domain's records to resource records for use with
+// github.com/miekg/dns
+func (c CardanoDnsDomain) ToRRSet() ([]dns.RR, error) {
+	ret := make([]dns.RR, 0, len(c.Records))
+	for idx, record := range c.Records {
+		rr, err := record.ToRR()
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", idx, err)
+		}
+		ret = append(ret, rr)
+	}
+	return ret, nil
+}
+
+// NewCardanoDnsDomainRecordFromRR creates a record from a github.com/miekg/dns resource record.
+// Names are stored without the trailing dot, to match the on-chain representation
+func NewCardanoDnsDomainRecordFromRR(rr dns.RR) (CardanoDnsDomainRecord, error) {
+	hdr := rr.Header()
+	ret := CardanoDnsDomainRecord{
+		Lhs: []byte(strings.TrimSuffix(hdr.Name, ".")),
+		Ttl: NewCardanoDnsMaybe[CardanoDnsTtl](CardanoDnsTtl(hdr.Ttl)),
+	}
+	switch v := rr.(type) {
+	case *dns.A:
+		ret.Type = []byte(CardanoDnsRecordTypeA)
+		ret.Rhs = []byte(v.A.String())
+	case *dns.AAAA:
+		ret.Type = []byte(CardanoDnsRecordTypeAAAA)
+		ret.Rhs = []byte(v.AAAA.String())
+	case *dns.NS:
+		ret.Type = []byte(CardanoDnsRecordTypeNS)
+		ret.Rhs = []byte(strings.TrimSuffix(v.Ns, "."))
+	case *dns.CNAME:
+		ret.Type = []byte(CardanoDnsRecordTypeCNAME)
+		ret.Rhs = []byte(strings.TrimSuffix(v.Target, "."))
+	case *dns.MX:
+		ret.SetMxData(
+			CardanoDnsMxData{
+				Preference: v.Preference,
+				Exchange:   strings.TrimSuffix(v.Mx, "."),
+			},
+		)
+	case *dns.TXT:
+		ret.Type = []byte(CardanoDnsRecordTypeTXT)
+		ret.Rhs = []byte(strings.Join(v.Txt, ""))
+	case *dns.SRV:
+		ret.SetSrvData(
+			CardanoDnsSrvData{
+				Priority: v.Priority,
+				Weight:   v.Weight,
+				Port:     v.Port,
+				Target:   strings.TrimSuffix(v.Target, "."),
+			},
+		)
+	case *dns.SOA:
+		ret.SetSoaData(
+			CardanoDnsSoaData{
+				Mname:   strings.TrimSuffix(v.Ns, "."),
+				Rname:   strings.TrimSuffix(v.Mbox, "."),
+				Serial:  v.Serial,
+				Refresh: v.Refresh,
+				Retry:   v.Retry,
+				Expire:  v.Expire,
+				Minimum: v.Minttl,
+			},
+		)
+	default:
+		return CardanoDnsDomainRecord{}, fmt.Errorf(
+			"unsupported DNS record type: %s",
+			dns.TypeToString[hdr.Rrtype],
+		)
+	}
+	return ret, nil
+}
+
+// WriteZoneFile writes the domain's records in BIND zone file format, with a $ORIGIN directive
+// for the domain origin. Records without a TTL are written without one, so that they use the
+// zone's default TTL
+func (c CardanoDnsDomain) WriteZoneFile(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "$ORIGIN %s\n", dns.Fqdn(string(c.Origin))); err != nil {
+		return err
+	}
+	for idx, record := range c.Records {
+		rr, err := record.ToRR()
+		if err != nil {
+			return fmt.Errorf("record %d: %w", idx, err)
+		}
+		line := rr.String()
+		if !record.Ttl.HasValue() {
+			// The TTL is the second tab-separated field
+			fields := strings.SplitN(line, "\t", 3)
+			line = fields[0] + "\t" + fields[2]
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseZoneFile reads a domain from BIND zone file format. The origin is taken from the first
+// $ORIGIN directive, or from the SOA record if there is no $ORIGIN directive. All parsed records
+// have a TTL, since records without one receive the zone's default TTL
+func ParseZoneFile(r io.Reader) (CardanoDnsDomain, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return CardanoDnsDomain{}, err
+	}
+	var ret CardanoDnsDomain
+	var hasOrigin bool
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && strings.EqualFold(fields[0], "$ORIGIN") {
+			ret.Origin = []byte(strings.TrimSuffix(fields[1], "."))
+			hasOrigin = true
+			break
+		}
+	}
+	zp := dns.NewZoneParser(bytes.NewReader(data), "", "")
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		if _, isSoa := rr.(*dns.SOA); isSoa && !hasOrigin {
+			ret.Origin = []byte(strings.TrimSuffix(rr.Header().Name, "."))
+			hasOrigin = true
+		}
+		record, err := NewCardanoDnsDomainRecordFromRR(rr)
+		if err != nil {
+			return CardanoDnsDomain{}, err
+		}
+		ret.Records = append(ret.Records, record)
+	}
+	if err := zp.Err(); err != nil {
+		return CardanoDnsDomain{}, err
+	}
+	return ret, nil
+}
+
+// CardanoDnsRecordProof is a signature over the CBOR encoding of a record, which allows resolvers
+// to check the integrity of records against a trusted signer, similar to DNSSEC
+type CardanoDnsRecordProof struct {
+	// SignerKeyHash is the blake2b-224 hash of the signer's verification key
+	SignerKeyHash []byte
+	// VerificationKey is the signer's ed25519 public key
+	VerificationKey []byte
+	// Signature is the ed25519 signature over the CBOR encoding of the record
+	Signature []byte
+}
+
+func (p *CardanoDnsRecordProof) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			p.SignerKeyHash,
+			p.VerificationKey,
+			p.Signature,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (p *CardanoDnsRecordProof) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 3)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(
+		fields,
+		&p.SignerKeyHash,
+		&p.VerificationKey,
+		&p.Signature,
+	)
+}
+
+// NewCardanoDnsRecordProof signs the CBOR encoding of the record with the provided key
+func NewCardanoDnsRecordProof(
+	record CardanoDnsDomainRecord,
+	privateKey ed25519.PrivateKey,
+) (CardanoDnsRecordProof, error) {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return CardanoDnsRecordProof{}, fmt.Errorf("invalid private key length: %d", len(privateKey))
+	}
+	cborData, err := record.MarshalCBOR()
+	if err != nil {
+		return CardanoDnsRecordProof{}, err
+	}
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	keyHash, err := cardanoDnsKeyHash(publicKey)
+	if err != nil {
+		return CardanoDnsRecordProof{}, err
+	}
+	return CardanoDnsRecordProof{
+		SignerKeyHash:   keyHash,
+		VerificationKey: publicKey,
+		Signature:       ed25519.Sign(privateKey, cborData),
+	}, nil
+}
+
+// Verify checks that the verification key matches the signer key hash and that the signature is
+// valid for the CBOR encoding of the provided record
+func (p CardanoDnsRecordProof) Verify(record CardanoDnsDomainRecord) error {
+	if len(p.VerificationKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid verification key length: %d", len(p.VerificationKey))
+	}
+	keyHash, err := cardanoDnsKeyHash(p.VerificationKey)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(keyHash, p.SignerKeyHash) {
+		return errors.New("verification key does not match signer key hash")
+	}
+	cborData, err := record.MarshalCBOR()
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(p.VerificationKey, cborData, p.Signature) {
+		return errors.New("invalid record signature")
+	}
+	return nil
+}
+
+// VerifySigner checks that the proof was made by the signer with the provided key hash, and that
+// it is valid for the provided record
+func (p CardanoDnsRecordProof) VerifySigner(record CardanoDnsDomainRecord, keyHash []byte) error {
+	if !bytes.Equal(p.SignerKeyHash, keyHash) {
+		return fmt.Errorf("unexpected signer key hash: %x", p.SignerKeyHash)
+	}
+	return p.Verify(record)
+}
+
+// cardanoDnsKeyHash returns the blake2b-224 hash of a verification key
+func cardanoDnsKeyHash(publicKey []byte) ([]byte, error) {
+	hasher, err := blake2b.New(28, nil)
+	if err != nil {
+		return nil, err
+	}
+	hasher.Write(publicKey)
+	return hasher.Sum(nil), nil
+}
+
+// CardanoDnsDomainBatch represents a registry datum that holds multiple domains in a single UTxO
+type CardanoDnsDomainBatch struct {
+	Domains []CardanoDnsDomain
+}
+
+func (c *CardanoDnsDomainBatch) MarshalCBOR() ([]byte, error) {
+	var domains any = []any{}
+	if len(c.Domains) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(c.Domains))
+		for idx := range c.Domains {
+			tmpList = append(tmpList, &c.Domains[idx])
+		}
+		domains = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			domains,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (c *CardanoDnsDomainBatch) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 1)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &c.Domains)
+}
+
+// ForEach calls the provided function for each domain in the batch, stopping at the first error
+func (c CardanoDnsDomainBatch) ForEach(fn func(CardanoDnsDomain) error) error {
+	for _, domain := range c.Domains {
+		if err := fn(domain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Origins returns the origins of the domains in the batch
+func (c CardanoDnsDomainBatch) Origins() []string {
+	ret := make([]string, 0, len(c.Domains))
+	for _, domain := range c.Domains {
+		ret = append(ret, string(domain.Origin))
+	}
+	return ret
+}
+
+// Domain returns the domain with the provided origin, compared case-insensitively. If the batch
+// contains multiple entries for the origin, they are merged
+func (c CardanoDnsDomainBatch) Domain(origin string) (CardanoDnsDomain, error) {
+	var ret CardanoDnsDomain
+	found := false
+	for _, domain := range c.Domains {
+		if cardanoDnsNameKey(domain.Origin) != cardanoDnsNameKey([]byte(origin)) {
+			continue
+		}
+		if !found {
+			ret = CardanoDnsDomain{
+				Origin:         domain.Origin,
+				Records:        append([]CardanoDnsDomainRecord{}, domain.Records...),
+				AdditionalData: domain.AdditionalData,
+			}
+			found = true
+			continue
+		}
+		if err := ret.Merge(domain); err != nil {
+			return CardanoDnsDomain{}, err
+		}
+	}
+	if !found {
+		return CardanoDnsDomain{}, fmt.Errorf("domain not found in batch: %s", origin)
+	}
+	return ret, nil
+}
+
+// Validate checks that all domains in the batch are valid
+func (c CardanoDnsDomainBatch) Validate() error {
+	for idx, domain := range c.Domains {
+		if err := domain.Validate(); err != nil {
+			return fmt.Errorf("domain %d: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+// CardanoDnsBridgeNamespace identifies the external namespace referenced by a bridge record
+type CardanoDnsBridgeNamespace uint
+
+const (
+	// CardanoDnsBridgeNamespaceHandle references an ADA Handle
+	CardanoDnsBridgeNamespaceHandle CardanoDnsBridgeNamespace = 0
+	// CardanoDnsBridgeNamespaceHns references a Handshake name
+	CardanoDnsBridgeNamespaceHns CardanoDnsBridgeNamespace = 1
+)
+
+// Policy ID of ADA Handle assets
+const cardanoDnsHandlePolicyId = "f0ff48bbb7bbe9d59a40f1ce90e9e9d0ff5002ec48f232b49ca0fb9a"
+
+// CIP-68 user token label prefix used for newer ADA Handle assets
+var cardanoDnsHandleCip68Prefix = []byte{0x00, 0x0d, 0xe1, 0x40}
+
+// CardanoDnsBridgeRecord maps a CardanoDNS name to a name in another namespace, such as an ADA
+// Handle or a Handshake name. The Handle namespace uses constructor 0 with the handle asset,
+// and the HNS namespace uses constructor 1 with the Handshake name
+type CardanoDnsBridgeRecord struct {
+	Lhs       []byte
+	Namespace CardanoDnsBridgeNamespace
+	// Asset is the referenced ADA Handle asset, for the Handle namespace
+	Asset PlutusAssetClass
+	// Name is the referenced Handshake name, for the HNS namespace
+	Name []byte
+}
+
+func (c *CardanoDnsBridgeRecord) MarshalCBOR() ([]byte, error) {
+	var tmp cbor.Constructor
+	switch c.Namespace {
+	case CardanoDnsBridgeNamespaceHandle:
+		tmp = cbor.NewConstructor(
+			uint(c.Namespace),
+			cbor.IndefLengthList{
+				c.Lhs,
+				&c.Asset,
+			},
+		)
+	case CardanoDnsBridgeNamespaceHns:
+		tmp = cbor.NewConstructor(
+			uint(c.Namespace),
+			cbor.IndefLengthList{
+				c.Lhs,
+				c.Name,
+			},
+		)
+	default:
+		return nil, fmt.Errorf("unknown bridge namespace: %d", c.Namespace)
+	}
+	return cbor.Encode(&tmp)
+}
+
+func (c *CardanoDnsBridgeRecord) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	c.Namespace = CardanoDnsBridgeNamespace(constr)
+	switch c.Namespace {
+	case CardanoDnsBridgeNamespaceHandle:
+		return decodePlutusFields(fields, &c.Lhs, &c.Asset)
+	case CardanoDnsBridgeNamespaceHns:
+		return decodePlutusFields(fields, &c.Lhs, &c.Name)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// HandleName returns the name of the referenced ADA Handle, without the CIP-68 label prefix
+func (c CardanoDnsBridgeRecord) HandleName() (string, error) {
+	if c.Namespace != CardanoDnsBridgeNamespaceHandle {
+		return "", fmt.Errorf("not a Handle bridge record: namespace %d", c.Namespace)
+	}
+	return string(bytes.TrimPrefix(c.Asset.AssetName, cardanoDnsHandleCip68Prefix)), nil
+}
+
+// Validate checks that the CardanoDNS name is valid and that the referenced identifier is valid
+// for the namespace
+func (c CardanoDnsBridgeRecord) Validate() error {
+	if err := validateCardanoDnsName(string(c.Lhs)); err != nil {
+		return err
+	}
+	switch c.Namespace {
+	case CardanoDnsBridgeNamespaceHandle:
+		if hex.EncodeToString(c.Asset.PolicyId) != cardanoDnsHandlePolicyId {
+			return fmt.Errorf("invalid ADA Handle policy ID: %x", c.Asset.PolicyId)
+		}
+		if len(c.Asset.AssetName) > 32 {
+			return fmt.Errorf("invalid ADA Handle asset name length: %d", len(c.Asset.AssetName))
+		}
+		// The Handle namespace has already been checked above
+		handle, _ := c.HandleName()
+		if len(handle) == 0 {
+			return errors.New("empty ADA Handle name")
+		}
+		for _, ch := range handle {
+			if (ch < 'a' || ch > 'z') && (ch < '0' || ch > '9') &&
+				ch != '-' && ch != '_' && ch != '.' && ch != '@' {
+				return fmt.Errorf("invalid character in ADA Handle name: %q", handle)
+			}
+		}
+	case CardanoDnsBridgeNamespaceHns:
+		name := string(c.Name)
+		if name != strings.ToLower(name) {
+			return fmt.Errorf("invalid Handshake name, must be lowercase: %q", name)
+		}
+		if err := validateCardanoDnsName(name); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown bridge namespace: %d", c.Namespace)
+	}
+	return nil
+}
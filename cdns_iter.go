@@ -0,0 +1,59 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+
+package models
+
+import (
+	"iter"
+)
+
+// AllRecords returns an iterator over the domain's records
+func (c CardanoDnsDomain) AllRecords() iter.Seq[CardanoDnsDomainRecord] {
+	return func(yield func(CardanoDnsDomainRecord) bool) {
+		for _, record := range c.Records {
+			if !yield(record) {
+				return
+			}
+		}
+	}
+}
+
+// RecordsOfType returns an iterator over the domain's records with the provided type. Records
+// with an unsupported type are skipped
+func (c CardanoDnsDomain) RecordsOfType(recordType CardanoDnsRecordType) iter.Seq[CardanoDnsDomainRecord] {
+	return func(yield func(CardanoDnsDomainRecord) bool) {
+		for _, record := range c.Records {
+			tmpType, err := record.RecordType()
+			if err != nil || tmpType != recordType {
+				continue
+			}
+			if !yield(record) {
+				return
+			}
+		}
+	}
+}
+
+// AllDomains returns an iterator over the domains in the batch
+func (c CardanoDnsDomainBatch) AllDomains() iter.Seq[CardanoDnsDomain] {
+	return func(yield func(CardanoDnsDomain) bool) {
+		for _, domain := range c.Domains {
+			if !yield(domain) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.23
+
+package models_test
+
+import (
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestCardanoDnsIterators(t *testing.T) {
+	testDomain := cardanoDnsTestDefs[1].expectedObj
+	var count int
+	for record := range testDomain.AllRecords() {
+		if string(record.Lhs) != "enclave.cardano" {
+			t.Fatalf("did not get expected record: %s", record.String())
+		}
+		count++
+	}
+	if count != len(testDomain.Records) {
+		t.Fatalf("did not get expected record count: %d", count)
+	}
+	var nsRecords []string
+	for record := range testDomain.RecordsOfType(models.CardanoDnsRecordTypeNS) {
+		nsRecords = append(nsRecords, string(record.Rhs))
+	}
+	if len(nsRecords) != 2 || nsRecords[0] != "ns1.enclave.cardano" || nsRecords[1] != "ns2.enclave.cardano" {
+		t.Fatalf("did not get expected NS records: %v", nsRecords)
+	}
+	// Stopping early
+	count = 0
+	for range testDomain.AllRecords() {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Fatalf("iterator did not stop early")
+	}
+	batch := models.CardanoDnsDomainBatch{
+		Domains: []models.CardanoDnsDomain{
+			cardanoDnsTestDefs[0].expectedObj,
+			cardanoDnsTestDefs[1].expectedObj,
+		},
+	}
+	var origins []string
+	for domain := range batch.AllDomains() {
+		origins = append(origins, string(domain.Origin))
+	}
+	if len(origins) != 2 || origins[0] != "village" || origins[1] != "enclave" {
+		t.Fatalf("did not get expected origins: %v", origins)
+	}
+}
@@ -15,13 +15,21 @@
 package models_test
 
 import (
+	"bytes"
+	"crypto/ed25519"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	models "github.com/blinklabs-io/cardano-models"
 
 	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/miekg/dns"
 )
 
 var cardanoDnsTestDefs = []struct {
@@ -82,7 +90,7 @@ var cardanoDnsTestDefs = []struct {
 	},
 }
 
-func TestCardanoDnsDecode(t *testing.T) {
+func TestCardanoDnsDecodeEncode(t *testing.T) {
 	for _, testDef := range cardanoDnsTestDefs {
 		testDatumBytes, err := hex.DecodeString(testDef.cborHex)
 		if err != nil {
@@ -100,5 +108,662 @@ func TestCardanoDnsDecode(t *testing.T) {
 				testDef.expectedObj.String(),
 			)
 		}
+		// Encode object back to CBOR
+		cborData, err := cbor.Encode(&testObj)
+		if err != nil {
+			t.Fatalf("unexpected error encoding object to CBOR: %s", err)
+		}
+		if hex.EncodeToString(cborData) != testDef.cborHex {
+			t.Fatalf(
+				"object did not encode to expected CBOR\n  got: %x\n  wanted: %s",
+				cborData,
+				testDef.cborHex,
+			)
+		}
+	}
+}
+
+func TestCardanoDnsJson(t *testing.T) {
+	testJson := []string{
+		`{"origin":"village","records":[{"lhs":"village.cardano","ttl":3600,"type":"A","rhs":"172.28.0.2"},{"lhs":"village.cardano","ttl":28800,"type":"ns","rhs":"ns1.village.cardano"}]}`,
+		`{"origin":"enclave","records":[{"lhs":"enclave.cardano","ttl":3600,"type":"A","rhs":"401.401.401.401"},{"lhs":"enclave.cardano","ttl":28800,"type":"ns","rhs":"ns1.enclave.cardano"},{"lhs":"enclave.cardano","ttl":3600,"type":"A","rhs":"172.28.0.2"},{"lhs":"enclave.cardano","type":"ns","rhs":"ns2.enclave.cardano"}]}`,
+	}
+	for idx, testDef := range cardanoDnsTestDefs {
+		jsonData, err := json.Marshal(testDef.expectedObj)
+		if err != nil {
+			t.Fatalf("unexpected error encoding JSON: %s", err)
+		}
+		if string(jsonData) != testJson[idx] {
+			t.Fatalf(
+				"object did not encode to expected JSON\n  got: %s\n  wanted: %s",
+				jsonData,
+				testJson[idx],
+			)
+		}
+		var testObj models.CardanoDnsDomain
+		if err := json.Unmarshal(jsonData, &testObj); err != nil {
+			t.Fatalf("unexpected error decoding JSON: %s", err)
+		}
+		if !reflect.DeepEqual(testObj, testDef.expectedObj) {
+			t.Fatalf(
+				"JSON did not decode to expected object\n  got: %s\n  wanted: %s",
+				testObj.String(),
+				testDef.expectedObj.String(),
+			)
+		}
+	}
+}
+
+func TestCardanoDnsValidate(t *testing.T) {
+	if err := cardanoDnsTestDefs[0].expectedObj.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+	// The second test domain contains an invalid A record
+	if err := cardanoDnsTestDefs[1].expectedObj.Validate(); err == nil {
+		t.Fatalf("did not get expected validation error")
+	}
+}
+
+func TestCardanoDnsRecordValidate(t *testing.T) {
+	testDefs := []struct {
+		recordType string
+		rhs        string
+		valid      bool
+	}{
+		{"A", "172.28.0.2", true},
+		{"a", "172.28.0.2", true},
+		{"A", "401.401.401.401", false},
+		{"A", "2001:db8::1", false},
+		{"AAAA", "2001:db8::1", true},
+		{"AAAA", "172.28.0.2", false},
+		{"NS", "ns1.village.cardano", true},
+		{"CNAME", "village.cardano.", true},
+		{"CNAME", "-bad.cardano", false},
+		{"MX", "10 mail.village.cardano", true},
+		{"MX", "mail.village.cardano", false},
+		{"MX", "70000 mail.village.cardano", false},
+		{"TXT", "v=spf1 -all", true},
+		{"TXT", "", false},
+		{"SRV", "10 60 5060 sip.village.cardano", true},
+		{"SRV", "10 60 sip.village.cardano", false},
+		{"SOA", "ns1.village.cardano hostmaster.village.cardano 2024010101 7200 3600 1209600 3600", true},
+		{"SOA", "ns1.village.cardano hostmaster.village.cardano 2024010101", false},
+		{"PTR", "village.cardano", false},
+	}
+	for _, testDef := range testDefs {
+		record := models.CardanoDnsDomainRecord{
+			Lhs:  []byte("village.cardano"),
+			Type: []byte(testDef.recordType),
+			Rhs:  []byte(testDef.rhs),
+		}
+		err := record.Validate()
+		if testDef.valid && err != nil {
+			t.Fatalf("unexpected validation error for %s record %q: %s", testDef.recordType, testDef.rhs, err)
+		}
+		if !testDef.valid && err == nil {
+			t.Fatalf("did not get expected validation error for %s record %q", testDef.recordType, testDef.rhs)
+		}
+	}
+}
+
+func TestParseCardanoDnsRecordType(t *testing.T) {
+	recordType, err := models.ParseCardanoDnsRecordType("ns")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if recordType != models.CardanoDnsRecordTypeNS {
+		t.Fatalf("did not get expected record type: got %s", recordType)
+	}
+	if _, err := models.ParseCardanoDnsRecordType("foo"); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
+
+func TestCardanoDnsTtl(t *testing.T) {
+	record := models.CardanoDnsDomainRecord{
+		Lhs:  []byte("village.cardano"),
+		Type: []byte("A"),
+		Rhs:  []byte("172.28.0.2"),
+		Ttl:  models.NewCardanoDnsMaybe[models.CardanoDnsTtl](nil),
+	}
+	if ttl := record.TtlOrDefault(300); ttl != 300 {
+		t.Fatalf("did not get expected default TTL: %d", ttl)
+	}
+	record.Ttl = models.NewCardanoDnsMaybe[models.CardanoDnsTtl](models.CardanoDnsTtl(3600))
+	if ttl := record.TtlOrDefault(300); ttl != 3600 {
+		t.Fatalf("did not get expected TTL: %d", ttl)
+	}
+	if duration := record.Ttl.Value.Duration(); duration != time.Hour {
+		t.Fatalf("did not get expected duration: %s", duration)
+	}
+	if err := record.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+	record.Ttl = models.NewCardanoDnsMaybe[models.CardanoDnsTtl](models.CardanoDnsTtl(math.MaxUint32 + 1))
+	if err := record.Validate(); err == nil {
+		t.Fatalf("did not get expected validation error")
+	}
+}
+
+func TestCardanoDnsStructuredRecords(t *testing.T) {
+	var record models.CardanoDnsDomainRecord
+	record.SetMxData(models.CardanoDnsMxData{Preference: 10, Exchange: "mail.village.cardano"})
+	if string(record.Type) != "MX" || string(record.Rhs) != "10 mail.village.cardano" {
+		t.Fatalf("did not get expected MX record: %s %s", record.Type, record.Rhs)
+	}
+	mxData, err := record.MxData()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if mxData.Preference != 10 || mxData.Exchange != "mail.village.cardano" {
+		t.Fatalf("did not get expected MX data: %#v", mxData)
+	}
+	// Accessors for other record types fail
+	if _, err := record.SrvData(); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+
+	expectedSrv := models.CardanoDnsSrvData{Priority: 10, Weight: 60, Port: 5060, Target: "sip.village.cardano"}
+	record.SetSrvData(expectedSrv)
+	if string(record.Rhs) != "10 60 5060 sip.village.cardano" {
+		t.Fatalf("did not get expected SRV record value: %s", record.Rhs)
+	}
+	srvData, err := record.SrvData()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *srvData != expectedSrv {
+		t.Fatalf("did not get expected SRV data: %#v", srvData)
+	}
+
+	// Record type is matched case-insensitively, as found on chain
+	record = models.CardanoDnsDomainRecord{
+		Lhs:  []byte("village.cardano"),
+		Type: []byte("soa"),
+		Rhs:  []byte("ns1.village.cardano hostmaster.village.cardano 2024010101 7200 3600 1209600 3600"),
+	}
+	soaData, err := record.SoaData()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expectedSoa := models.CardanoDnsSoaData{
+		Mname:   "ns1.village.cardano",
+		Rname:   "hostmaster.village.cardano",
+		Serial:  2024010101,
+		Refresh: 7200,
+		Retry:   3600,
+		Expire:  1209600,
+		Minimum: 3600,
+	}
+	if *soaData != expectedSoa {
+		t.Fatalf("did not get expected SOA data: %#v", soaData)
+	}
+	if soaData.String() != string(record.Rhs) {
+		t.Fatalf("SOA data did not encode to original value: %s", soaData.String())
+	}
+}
+
+func TestCardanoDnsToRRSet(t *testing.T) {
+	testDomain := cardanoDnsTestDefs[0].expectedObj
+	rrSet, err := testDomain.ToRRSet()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expectedRRs := []string{
+		"village.cardano.\t3600\tIN\tA\t172.28.0.2",
+		"village.cardano.\t28800\tIN\tNS\tns1.village.cardano.",
+	}
+	if len(rrSet) != len(expectedRRs) {
+		t.Fatalf("did not get expected number of records: got %d", len(rrSet))
+	}
+	for idx, rr := range rrSet {
+		if rr.String() != expectedRRs[idx] {
+			t.Fatalf("did not get expected record\n  got: %s\n  wanted: %s", rr.String(), expectedRRs[idx])
+		}
+		record, err := models.NewCardanoDnsDomainRecordFromRR(rr)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		// Record types are normalized to uppercase
+		expectedRecord := testDomain.Records[idx]
+		expectedRecord.Type = bytes.ToUpper(expectedRecord.Type)
+		if !reflect.DeepEqual(record, expectedRecord) {
+			t.Fatalf(
+				"did not get expected record\n  got: %s\n  wanted: %s",
+				record.String(),
+				expectedRecord.String(),
+			)
+		}
+	}
+	// The second test domain contains an invalid A record
+	if _, err := cardanoDnsTestDefs[1].expectedObj.ToRRSet(); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
+
+func TestCardanoDnsRRRoundTrip(t *testing.T) {
+	testRRs := []string{
+		"village.cardano.\t3600\tIN\tAAAA\t2001:db8::1",
+		"www.village.cardano.\t3600\tIN\tCNAME\tvillage.cardano.",
+		"village.cardano.\t3600\tIN\tMX\t10 mail.village.cardano.",
+		"village.cardano.\t3600\tIN\tTXT\t\"v=spf1 -all\"",
+		"_sip._tcp.village.cardano.\t3600\tIN\tSRV\t10 60 5060 sip.village.cardano.",
+		"village.cardano.\t3600\tIN\tSOA\tns1.village.cardano. hostmaster.village.cardano. 2024010101 7200 3600 1209600 3600",
+	}
+	for _, testRR := range testRRs {
+		rr, err := dns.NewRR(testRR)
+		if err != nil {
+			t.Fatalf("unexpected error parsing test record: %s", err)
+		}
+		record, err := models.NewCardanoDnsDomainRecordFromRR(rr)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		newRR, err := record.ToRR()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if newRR.String() != testRR {
+			t.Fatalf("did not get expected record\n  got: %s\n  wanted: %s", newRR.String(), testRR)
+		}
+	}
+	// Unsupported record types are rejected
+	rr, err := dns.NewRR("1.0.0.127.in-addr.arpa. 3600 IN PTR village.cardano.")
+	if err != nil {
+		t.Fatalf("unexpected error parsing test record: %s", err)
+	}
+	if _, err := models.NewCardanoDnsDomainRecordFromRR(rr); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
+
+func TestCardanoDnsZoneFile(t *testing.T) {
+	testDomain := models.CardanoDnsDomain{
+		Origin: []byte("village"),
+		Records: []models.CardanoDnsDomainRecord{
+			{
+				Lhs:  []byte("village.cardano"),
+				Type: []byte("A"),
+				Rhs:  []byte("172.28.0.2"),
+				Ttl:  models.NewCardanoDnsMaybe[models.CardanoDnsTtl](models.CardanoDnsTtl(3600)),
+			},
+			{
+				Lhs:  []byte("village.cardano"),
+				Type: []byte("NS"),
+				Rhs:  []byte("ns1.village.cardano"),
+				Ttl:  models.NewCardanoDnsMaybe[models.CardanoDnsTtl](nil),
+			},
+		},
+	}
+	expectedZoneFile := "$ORIGIN village.\n" +
+		"village.cardano.\t3600\tIN\tA\t172.28.0.2\n" +
+		"village.cardano.\tIN\tNS\tns1.village.cardano.\n"
+	var buf bytes.Buffer
+	if err := testDomain.WriteZoneFile(&buf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if buf.String() != expectedZoneFile {
+		t.Fatalf("did not get expected zone file\n  got: %q\n  wanted: %q", buf.String(), expectedZoneFile)
+	}
+	testObj, err := models.ParseZoneFile(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Records without a TTL use the default when parsed
+	testDomain.Records[1].Ttl = models.NewCardanoDnsMaybe[models.CardanoDnsTtl](models.CardanoDnsTtl(3600))
+	if !reflect.DeepEqual(testObj, testDomain) {
+		t.Fatalf(
+			"zone file did not parse to expected object\n  got: %s\n  wanted: %s",
+			testObj.String(),
+			testDomain.String(),
+		)
+	}
+}
+
+func TestCardanoDnsParseZoneFileRelative(t *testing.T) {
+	// The origin is taken from the SOA record when there is no $ORIGIN directive
+	zoneFile := `$TTL 300
+village.cardano. IN SOA ns1.village.cardano. hostmaster.village.cardano. 2024010101 7200 3600 1209600 3600
+village.cardano. IN NS ns1.village.cardano.
+www.village.cardano. IN A 172.28.0.2
+`
+	testObj, err := models.ParseZoneFile(strings.NewReader(zoneFile))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(testObj.Origin) != "village.cardano" {
+		t.Fatalf("did not get expected origin: %s", testObj.Origin)
+	}
+	if len(testObj.Records) != 3 {
+		t.Fatalf("did not get expected number of records: %d", len(testObj.Records))
+	}
+	if string(testObj.Records[2].Lhs) != "www.village.cardano" || testObj.Records[2].Ttl.Value != 300 {
+		t.Fatalf("did not get expected record: %s", testObj.Records[2].String())
+	}
+	// Relative names are resolved against $ORIGIN
+	testObj, err = models.ParseZoneFile(strings.NewReader("$ORIGIN village.cardano.\nwww 300 IN A 172.28.0.2\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(testObj.Origin) != "village.cardano" || string(testObj.Records[0].Lhs) != "www.village.cardano" {
+		t.Fatalf("did not get expected record: %s", testObj.Records[0].String())
+	}
+	if _, err := models.ParseZoneFile(strings.NewReader("www IN A 172.28.0.2\n")); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
+
+func TestCardanoDnsString(t *testing.T) {
+	testDomain := models.CardanoDnsDomain{
+		Origin: []byte("village"),
+		Records: []models.CardanoDnsDomainRecord{
+			{
+				Lhs:  []byte("village.cardano"),
+				Type: []byte("A"),
+				Rhs:  []byte("172.28.0.2"),
+				Ttl:  models.NewCardanoDnsMaybe[models.CardanoDnsTtl](models.CardanoDnsTtl(3600)),
+			},
+			{
+				Lhs:  []byte("village.cardano"),
+				Type: []byte("NS"),
+				Rhs:  []byte("ns1.village.cardano"),
+				Ttl:  models.NewCardanoDnsMaybe[models.CardanoDnsTtl](nil),
+			},
+		},
+		AdditionalData: models.NewCardanoDnsMaybe[any](nil),
+	}
+	expectedString := "CardanoDnsDomain { Origin = village, Records = [ " +
+		"CardanoDnsDomainRecord { Lhs = village.cardano, Ttl = Some(3600), Type = A, Rhs = 172.28.0.2 }, " +
+		"CardanoDnsDomainRecord { Lhs = village.cardano, Ttl = None, Type = NS, Rhs = ns1.village.cardano } " +
+		"], AdditionalData = None }"
+	if testDomain.String() != expectedString {
+		t.Fatalf("did not get expected string\n  got: %s\n  wanted: %s", testDomain.String(), expectedString)
+	}
+	expectedGoString := `models.CardanoDnsDomainRecord{Lhs: []byte("village.cardano"), ` +
+		`Ttl: models.NewCardanoDnsMaybe[models.CardanoDnsTtl](nil), Type: []byte("NS"), Rhs: []byte("ns1.village.cardano")}`
+	if goString := fmt.Sprintf("%#v", testDomain.Records[1]); goString != expectedGoString {
+		t.Fatalf("did not get expected Go string\n  got: %s\n  wanted: %s", goString, expectedGoString)
+	}
+	if goString := fmt.Sprintf("%#v", testDomain.Records[0].Ttl); goString != "models.NewCardanoDnsMaybe[models.CardanoDnsTtl](0xe10)" {
+		t.Fatalf("did not get expected Go string: %s", goString)
+	}
+}
+
+func TestNormalizeCardanoDnsName(t *testing.T) {
+	testDefs := []struct {
+		name     string
+		expected string
+		valid    bool
+	}{
+		{"village.cardano", "village.cardano", true},
+		{"Village.Cardano.", "village.cardano", true},
+		{"_sip._tcp.village.cardano", "_sip._tcp.village.cardano", true},
+		{"bücher.cardano", "xn--bcher-kva.cardano", true},
+		{"village..cardano", "", false},
+		{"-bad.cardano", "", false},
+		{"", "", false},
+	}
+	for _, testDef := range testDefs {
+		name, err := models.NormalizeCardanoDnsName(testDef.name)
+		if testDef.valid {
+			if err != nil {
+				t.Fatalf("unexpected error for name %q: %s", testDef.name, err)
+			}
+			if name != testDef.expected {
+				t.Fatalf("did not get expected name for %q: got %q, wanted %q", testDef.name, name, testDef.expected)
+			}
+		} else if err == nil {
+			t.Fatalf("did not get expected error for name %q", testDef.name)
+		}
+	}
+}
+
+func TestCardanoDnsDomainNormalize(t *testing.T) {
+	testDomain := models.CardanoDnsDomain{
+		Origin: []byte("Bücher."),
+		Records: []models.CardanoDnsDomainRecord{
+			{
+				Lhs:  []byte("WWW.Bücher.Cardano."),
+				Type: []byte("A"),
+				Rhs:  []byte("172.28.0.2"),
+			},
+		},
+	}
+	if err := testDomain.Normalize(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(testDomain.Origin) != "xn--bcher-kva" {
+		t.Fatalf("did not get expected origin: %s", testDomain.Origin)
+	}
+	if string(testDomain.Records[0].Lhs) != "www.xn--bcher-kva.cardano" {
+		t.Fatalf("did not get expected record name: %s", testDomain.Records[0].Lhs)
+	}
+	testDomain.Records[0].Lhs = []byte("bad..name")
+	if err := testDomain.Normalize(); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
+
+func TestCardanoDnsDomainMerge(t *testing.T) {
+	testDomain := models.CardanoDnsDomain{
+		Origin: []byte("village"),
+		Records: []models.CardanoDnsDomainRecord{
+			{Lhs: []byte("village.cardano"), Type: []byte("NS"), Rhs: []byte("ns1.village.cardano")},
+			{Lhs: []byte("www.village.cardano"), Type: []byte("CNAME"), Rhs: []byte("village.cardano")},
+		},
+	}
+	otherDomain := models.CardanoDnsDomain{
+		Origin: []byte("Village."),
+		Records: []models.CardanoDnsDomainRecord{
+			{Lhs: []byte("Village.Cardano."), Type: []byte("ns"), Rhs: []byte("ns1.village.cardano")},
+			{Lhs: []byte("village.cardano"), Type: []byte("NS"), Rhs: []byte("ns2.village.cardano")},
+			{Lhs: []byte("www.village.cardano"), Type: []byte("CNAME"), Rhs: []byte("village.cardano")},
+		},
+		AdditionalData: models.NewCardanoDnsMaybe[any]([]byte("foo")),
+	}
+	if err := testDomain.Merge(otherDomain); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expectedRecords := []models.CardanoDnsDomainRecord{
+		{Lhs: []byte("village.cardano"), Type: []byte("NS"), Rhs: []byte("ns1.village.cardano")},
+		{Lhs: []byte("www.village.cardano"), Type: []byte("CNAME"), Rhs: []byte("village.cardano")},
+		{Lhs: []byte("village.cardano"), Type: []byte("NS"), Rhs: []byte("ns2.village.cardano")},
+	}
+	if !reflect.DeepEqual(testDomain.Records, expectedRecords) {
+		t.Fatalf("did not get expected records: %#v", testDomain.Records)
+	}
+	if !testDomain.AdditionalData.HasValue() {
+		t.Fatalf("did not get expected additional data")
+	}
+	// Conflicting CNAME
+	conflictDomain := models.CardanoDnsDomain{
+		Origin: []byte("village"),
+		Records: []models.CardanoDnsDomainRecord{
+			{Lhs: []byte("www.village.cardano"), Type: []byte("CNAME"), Rhs: []byte("other.cardano")},
+		},
+	}
+	if err := testDomain.Merge(conflictDomain); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+	// Different origin
+	if err := testDomain.Merge(models.CardanoDnsDomain{Origin: []byte("other")}); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+	if len(testDomain.Records) != 3 {
+		t.Fatalf("records were modified by failed merge")
+	}
+}
+
+func TestCardanoDnsRecordProof(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x42}, ed25519.SeedSize)
+	privateKey := ed25519.NewKeyFromSeed(seed)
+	record := models.CardanoDnsDomainRecord{
+		Lhs:  []byte("village.cardano"),
+		Type: []byte("A"),
+		Rhs:  []byte("172.28.0.2"),
+		Ttl:  models.NewCardanoDnsMaybe[models.CardanoDnsTtl](models.CardanoDnsTtl(3600)),
+	}
+	proof, err := models.NewCardanoDnsRecordProof(record, privateKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := proof.Verify(record); err != nil {
+		t.Fatalf("unexpected verification error: %s", err)
+	}
+	if err := proof.VerifySigner(record, proof.SignerKeyHash); err != nil {
+		t.Fatalf("unexpected verification error: %s", err)
+	}
+	if err := proof.VerifySigner(record, make([]byte, 28)); err == nil {
+		t.Fatalf("did not get expected error for wrong signer")
+	}
+	// Proof survives a CBOR round trip
+	cborData, err := cbor.Encode(&proof)
+	if err != nil {
+		t.Fatalf("unexpected error encoding proof: %s", err)
+	}
+	var decodedProof models.CardanoDnsRecordProof
+	if _, err := cbor.Decode(cborData, &decodedProof); err != nil {
+		t.Fatalf("unexpected error decoding proof: %s", err)
+	}
+	if !reflect.DeepEqual(decodedProof, proof) {
+		t.Fatalf("proof did not round trip: %#v", decodedProof)
+	}
+	// Modified record
+	modifiedRecord := record
+	modifiedRecord.Rhs = []byte("172.28.0.3")
+	if err := proof.Verify(modifiedRecord); err == nil {
+		t.Fatalf("did not get expected error for modified record")
+	}
+	// Mismatched key hash
+	otherKey := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{0x43}, ed25519.SeedSize))
+	badProof := proof
+	badProof.VerificationKey = otherKey.Public().(ed25519.PublicKey)
+	if err := badProof.Verify(record); err == nil {
+		t.Fatalf("did not get expected error for mismatched key")
+	}
+}
+
+func TestCardanoDnsDomainBatch(t *testing.T) {
+	testHex := "d8799f9f" + cardanoDnsTestDefs[0].cborHex + cardanoDnsTestDefs[1].cborHex + "ffff"
+	expectedObj := models.CardanoDnsDomainBatch{
+		Domains: []models.CardanoDnsDomain{
+			cardanoDnsTestDefs[0].expectedObj,
+			cardanoDnsTestDefs[1].expectedObj,
+		},
+	}
+	var testObj models.CardanoDnsDomainBatch
+	testDecodeEncode(t, testHex, &testObj, &expectedObj)
+	if origins := testObj.Origins(); !reflect.DeepEqual(origins, []string{"village", "enclave"}) {
+		t.Fatalf("did not get expected origins: %v", origins)
+	}
+	var count int
+	if err := testObj.ForEach(func(models.CardanoDnsDomain) error {
+		count++
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if count != 2 {
+		t.Fatalf("did not get expected domain count: %d", count)
+	}
+	domain, err := testObj.Domain("Enclave")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(domain, cardanoDnsTestDefs[1].expectedObj) {
+		t.Fatalf("did not get expected domain: %s", domain.String())
+	}
+	if _, err := testObj.Domain("other"); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+	// Entries for the same origin are merged
+	testObj.Domains = append(testObj.Domains, models.CardanoDnsDomain{
+		Origin: []byte("village"),
+		Records: []models.CardanoDnsDomainRecord{
+			{Lhs: []byte("www.village.cardano"), Type: []byte("A"), Rhs: []byte("172.28.0.3")},
+		},
+	})
+	domain, err = testObj.Domain("village")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(domain.Records) != 3 || len(testObj.Domains[0].Records) != 2 {
+		t.Fatalf("did not get expected merged domain: %s", domain.String())
+	}
+}
+
+func TestCardanoDnsBridgeRecord(t *testing.T) {
+	handleRecord := models.CardanoDnsBridgeRecord{
+		Lhs:       []byte("village.cardano"),
+		Namespace: models.CardanoDnsBridgeNamespaceHandle,
+		Asset: models.PlutusAssetClass{
+			PolicyId:  decodeHex("f0ff48bbb7bbe9d59a40f1ce90e9e9d0ff5002ec48f232b49ca0fb9a"),
+			AssetName: decodeHex("000de14076696c6c616765"),
+		},
+	}
+	var testHandleObj models.CardanoDnsBridgeRecord
+	testDecodeEncode(
+		t,
+		"d8799f4f76696c6c6167652e63617264616e6fd8799f581cf0ff48bbb7bbe9d59a40f1ce90e9e9d0ff5002ec48f232b49ca0fb9a4b000de14076696c6c616765ffff",
+		&testHandleObj,
+		&handleRecord,
+	)
+	if err := testHandleObj.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+	handle, err := testHandleObj.HandleName()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if handle != "village" {
+		t.Fatalf("did not get expected handle name: %s", handle)
+	}
+
+	hnsRecord := models.CardanoDnsBridgeRecord{
+		Lhs:       []byte("village.cardano"),
+		Namespace: models.CardanoDnsBridgeNamespaceHns,
+		Name:      []byte("village"),
+	}
+	var testHnsObj models.CardanoDnsBridgeRecord
+	testDecodeEncode(
+		t,
+		"d87a9f4f76696c6c6167652e63617264616e6f4776696c6c616765ff",
+		&testHnsObj,
+		&hnsRecord,
+	)
+	if err := testHnsObj.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+	if _, err := testHnsObj.HandleName(); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+
+	// Invalid records
+	badPolicy := handleRecord
+	badPolicy.Asset.PolicyId = make([]byte, 28)
+	badHandle := handleRecord
+	badHandle.Asset.AssetName = []byte("Village!")
+	badHns := hnsRecord
+	badHns.Name = []byte("Village")
+	badNamespace := hnsRecord
+	badNamespace.Namespace = 2
+	for _, record := range []models.CardanoDnsBridgeRecord{badPolicy, badHandle, badHns, badNamespace} {
+		if err := record.Validate(); err == nil {
+			t.Fatalf("did not get expected validation error for record: %#v", record)
+		}
+	}
+}
+
+func TestCardanoDnsDomainLogValue(t *testing.T) {
+	domain := models.CardanoDnsDomain{
+		Origin: []byte("foo.cardano"),
+		Records: []models.CardanoDnsDomainRecord{
+			{Lhs: []byte("foo.cardano"), Type: []byte("A"), Rhs: []byte("1.2.3.4")},
+			{Lhs: []byte("foo.cardano"), Type: []byte("NS"), Rhs: []byte("ns1.foo.cardano")},
+		},
+	}
+	expected := "[origin=foo.cardano records=2]"
+	if logValue := domain.LogValue().String(); logValue != expected {
+		t.Fatalf("did not get expected log value\n  got:    %s\n  wanted: %s", logValue, expected)
 	}
 }
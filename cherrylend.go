@@ -0,0 +1,148 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// CherryLendLoanTerms represents the terms of a Cherry Lend P2P loan
+type CherryLendLoanTerms struct {
+	LoanAsset        PlutusAssetClass
+	LoanAmount       int64
+	CollateralAsset  PlutusAssetClass
+	CollateralAmount int64
+	// Fixed interest paid to the lender on repayment, in the loan asset
+	InterestAmount int64
+	// Loan duration in milliseconds
+	Duration int64
+	// Oracle identifies the oracle NFT used to value the collateral for liquidation. It is
+	// nil when the loan can only be liquidated after it expires
+	Oracle *PlutusAssetClass
+}
+
+func (c *CherryLendLoanTerms) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&c.LoanAsset,
+			c.LoanAmount,
+			&c.CollateralAsset,
+			c.CollateralAmount,
+			c.InterestAmount,
+			c.Duration,
+			encodePlutusMaybe(c.Oracle),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (c *CherryLendLoanTerms) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 7)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpOracle cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&c.LoanAsset,
+		&c.LoanAmount,
+		&c.CollateralAsset,
+		&c.CollateralAmount,
+		&c.InterestAmount,
+		&c.Duration,
+		&tmpOracle,
+	); err != nil {
+		return err
+	}
+	oracle, err := decodePlutusMaybe[PlutusAssetClass](tmpOracle)
+	if err != nil {
+		return err
+	}
+	c.Oracle = oracle
+	return nil
+}
+
+// InterestRate returns the interest paid over the loan duration as a fraction of the loan amount.
+// It returns nil for a zero loan amount
+func (c CherryLendLoanTerms) InterestRate() *big.Rat {
+	if c.LoanAmount == 0 {
+		return nil
+	}
+	return big.NewRat(c.InterestAmount, c.LoanAmount)
+}
+
+// CherryLendLoanState identifies whether a Cherry Lend loan is an open offer or an active loan
+type CherryLendLoanState uint
+
+const (
+	CherryLendLoanStateOffer  CherryLendLoanState = 0
+	CherryLendLoanStateActive CherryLendLoanState = 1
+)
+
+// CherryLendLoanDatum represents the datum format used by the Cherry Lend loan contract for
+// both open loan offers and active loans
+type CherryLendLoanDatum struct {
+	State  CherryLendLoanState
+	Lender PlutusAddress
+	Terms  CherryLendLoanTerms
+	// Used by active loans
+	Borrower PlutusAddress
+	// StartTime is the POSIX time (in milliseconds) at which the loan was taken
+	StartTime int64
+}
+
+func (c *CherryLendLoanDatum) MarshalCBOR() ([]byte, error) {
+	var fields cbor.IndefLengthList
+	switch c.State {
+	case CherryLendLoanStateOffer:
+		fields = cbor.IndefLengthList{&c.Lender, &c.Terms}
+	case CherryLendLoanStateActive:
+		fields = cbor.IndefLengthList{&c.Lender, &c.Terms, &c.Borrower, c.StartTime}
+	default:
+		return nil, fmt.Errorf("unknown loan state: %d", c.State)
+	}
+	tmp := cbor.NewConstructor(uint(c.State), fields)
+	return cbor.Encode(&tmp)
+}
+
+func (c *CherryLendLoanDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*c = CherryLendLoanDatum{
+		State: CherryLendLoanState(constr),
+	}
+	switch c.State {
+	case CherryLendLoanStateOffer:
+		return decodePlutusFields(fields, &c.Lender, &c.Terms)
+	case CherryLendLoanStateActive:
+		return decodePlutusFields(fields, &c.Lender, &c.Terms, &c.Borrower, &c.StartTime)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// Maturity returns the POSIX time (in milliseconds) at which an active loan expires
+func (c CherryLendLoanDatum) Maturity() int64 {
+	return c.StartTime + c.Terms.Duration
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"math/big"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestCherryLendLoanDatumDecodeEncode(t *testing.T) {
+	milkAsset := models.PlutusAssetClass{
+		PolicyId:  decodeHex("8a1cfae21368b8bebbbed9800fec304e95cce39a2a57dc35e2e3ebaa"),
+		AssetName: []byte("MILK"),
+	}
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.CherryLendLoanDatum
+	}{
+		{
+			// Loan offer using an oracle
+			cborHex: "d8799fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffd8799fd8799f4040ff1a1dcd6500d8799f581c8a1cfae21368b8bebbbed9800fec304e95cce39a2a57dc35e2e3ebaa444d494c4bff1a002625a01a017d78401a9a7ec800d8799fd8799f581cdddddddddddddddddddddddddddddddddddddddddddddddddddddddd4a4f7261636c6546656564ffffffff",
+			expectedObj: models.CherryLendLoanDatum{
+				State:  models.CherryLendLoanStateOffer,
+				Lender: testPlutusAddressBase,
+				Terms: models.CherryLendLoanTerms{
+					LoanAsset:        testLovelaceAsset,
+					LoanAmount:       500000000,
+					CollateralAsset:  milkAsset,
+					CollateralAmount: 2500000,
+					InterestAmount:   25000000,
+					Duration:         2592000000,
+					Oracle: &models.PlutusAssetClass{
+						PolicyId:  decodeHex("dddddddddddddddddddddddddddddddddddddddddddddddddddddddd"),
+						AssetName: []byte("OracleFeed"),
+					},
+				},
+			},
+		},
+		{
+			// Active loan without an oracle
+			cborHex: "d87a9fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffd8799fd8799f4040ff1a1dcd6500d8799f581c8a1cfae21368b8bebbbed9800fec304e95cce39a2a57dc35e2e3ebaa444d494c4bff1a002625a01a017d78401a9a7ec800d87a80ffd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ff1b000001941f297c00ff",
+			expectedObj: models.CherryLendLoanDatum{
+				State:  models.CherryLendLoanStateActive,
+				Lender: testPlutusAddressBase,
+				Terms: models.CherryLendLoanTerms{
+					LoanAsset:        testLovelaceAsset,
+					LoanAmount:       500000000,
+					CollateralAsset:  milkAsset,
+					CollateralAmount: 2500000,
+					InterestAmount:   25000000,
+					Duration:         2592000000,
+				},
+				Borrower:  testPlutusAddressScript,
+				StartTime: 1735689600000,
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.CherryLendLoanDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+		if testObj.Terms.InterestRate().Cmp(big.NewRat(1, 20)) != 0 {
+			t.Fatalf("did not get expected interest rate: got %s", testObj.Terms.InterestRate())
+		}
+	}
+}
@@ -14,29 +14,303 @@
 
 package models
 
-import "github.com/go-playground/validator/v10"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+// Maximum length in bytes of a text string in transaction metadata
+const metadataMaxStringBytes = 64
 
 type Cip20Metadata struct {
 	Num674 Num674 `cbor:"674,keyasint" json:"674" validate:"required"`
+
+	// ChunkOnEncode splits messages that exceed the metadata string length limit when encoding
+	// to CBOR, instead of returning an error. The messages themselves are not modified. Extra
+	// values have no defined chunked form, so an error is always returned for those
+	ChunkOnEncode bool `cbor:"-" json:"-"`
 }
 
 type Num674 struct {
-	Msg []string `cbor:"msg" json:"msg" validate:"required,gt=0,dive,max=64"`
+	Msg []string `cbor:"msg" json:"msg" validate:"required,gt=0,dive,maxbytes=64"`
+	// Extra holds any additional keys present alongside "msg"
+	Extra map[string]any `cbor:"-" json:"-"`
 }
 
-func NewCip20Metadata(messages []string) (*Cip20Metadata, error) {
-	validate := validator.New()
+func (n *Num674) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	n.Msg = nil
+	n.Extra = nil
+	for key, val := range raw {
+		if key == "msg" {
+			if err := json.Unmarshal(val, &n.Msg); err != nil {
+				return err
+			}
+			continue
+		}
+		dec := json.NewDecoder(bytes.NewReader(val))
+		dec.UseNumber()
+		var tmpVal any
+		if err := dec.Decode(&tmpVal); err != nil {
+			return err
+		}
+		metadatum, err := jsonToMetadatum(tmpVal)
+		if err != nil {
+			return fmt.Errorf("invalid value for key %q: %w", key, err)
+		}
+		if n.Extra == nil {
+			n.Extra = make(map[string]any)
+		}
+		n.Extra[key] = metadatum
+	}
+	return nil
+}
+
+// MarshalJSON encodes the message, converting extra values decoded from CBOR (such as maps with
+// non-string keys and byte strings) to their JSON representation
+func (n Num674) MarshalJSON() ([]byte, error) {
+	tmpMap := n.toMap()
+	for key, val := range tmpMap {
+		if key != "msg" {
+			tmpMap[key] = metadatumToJson(val)
+		}
+	}
+	return json.Marshal(tmpMap)
+}
+
+func (n *Num674) UnmarshalCBOR(cborData []byte) error {
+	var raw map[string]cbor.RawMessage
+	if _, err := cbor.Decode(cborData, &raw); err != nil {
+		return err
+	}
+	n.Msg = nil
+	n.Extra = nil
+	for key, val := range raw {
+		if key == "msg" {
+			if _, err := cbor.Decode(val, &n.Msg); err != nil {
+				return err
+			}
+			continue
+		}
+		var tmpVal any
+		if _, err := cbor.Decode(val, &tmpVal); err != nil {
+			return err
+		}
+		if n.Extra == nil {
+			n.Extra = make(map[string]any)
+		}
+		n.Extra[key] = tmpVal
+	}
+	return nil
+}
 
+func (n Num674) MarshalCBOR() ([]byte, error) {
+	return cbor.Encode(n.toMap())
+}
+
+func (n Num674) toMap() map[string]any {
+	ret := make(map[string]any, len(n.Extra)+1)
+	for key, val := range n.Extra {
+		ret[key] = val
+	}
+	// The "msg" field always takes precedence over an extra key with the same name
+	ret["msg"] = n.Msg
+	return ret
+}
+
+func NewCip20Metadata(messages []string) (*Cip20Metadata, error) {
 	metadata := &Cip20Metadata{Num674: Num674{Msg: messages}}
 
-	if err := validate.Struct(metadata); err != nil {
+	if err := packageValidator().Struct(metadata); err != nil {
 		return nil, err
 	}
 
 	return metadata, nil
 }
 
+// NewCip20MetadataFromText creates CIP-20 metadata from a single message, splitting it into
+// chunks that fit within the metadata string length limit
+func NewCip20MetadataFromText(text string) (*Cip20Metadata, error) {
+	return NewCip20Metadata(splitMetadataString(text))
+}
+
+// MarshalCBOR encodes the metadata. If any message exceeds the metadata string length limit, it's
+// split into chunks when ChunkOnEncode is set, and otherwise an error is returned, since the node
+// would reject it. An error is also returned for any string or byte string in the extra values
+// that exceeds the limit
+func (c Cip20Metadata) MarshalCBOR() ([]byte, error) {
+	if c.ChunkOnEncode {
+		c.Num674.Msg = chunkMetadataStrings(c.Num674.Msg)
+		c.ChunkOnEncode = false
+		return c.MarshalCBOR()
+	}
+	for idx, msg := range c.Num674.Msg {
+		if len(msg) > metadataMaxStringBytes {
+			return nil, fmt.Errorf(
+				"message %d is %d bytes, which exceeds the limit of %d bytes",
+				idx,
+				len(msg),
+				metadataMaxStringBytes,
+			)
+		}
+	}
+	for key, val := range c.Num674.Extra {
+		if err := checkMetadatumLength(key); err != nil {
+			return nil, fmt.Errorf("extra key %q: %w", key, err)
+		}
+		if err := checkMetadatumLength(val); err != nil {
+			return nil, fmt.Errorf("extra key %q: %w", key, err)
+		}
+	}
+	type tmpCip20Metadata Cip20Metadata
+	return cbor.Encode((*tmpCip20Metadata)(&c))
+}
+
+// ChunkMessages splits any messages that exceed the metadata string length limit into multiple
+// messages, so that the metadata can be encoded
+func (c *Cip20Metadata) ChunkMessages() {
+	c.Num674.Msg = chunkMetadataStrings(c.Num674.Msg)
+}
+
+// chunkMetadataStrings returns the strings with any that exceed the metadata string length limit
+// split into chunks
+func chunkMetadataStrings(strs []string) []string {
+	var ret []string
+	for _, str := range strs {
+		if len(str) <= metadataMaxStringBytes {
+			ret = append(ret, str)
+			continue
+		}
+		ret = append(ret, splitMetadataString(str)...)
+	}
+	return ret
+}
+
+// checkMetadatumLength returns an error if the value contains a text or byte string that exceeds
+// the metadata string length limit
+func checkMetadatumLength(val any) error {
+	switch v := val.(type) {
+	case string:
+		if len(v) > metadataMaxStringBytes {
+			return fmt.Errorf(
+				"string is %d bytes, which exceeds the limit of %d bytes",
+				len(v),
+				metadataMaxStringBytes,
+			)
+		}
+	case []byte:
+		if len(v) > metadataMaxStringBytes {
+			return fmt.Errorf(
+				"byte string is %d bytes, which exceeds the limit of %d bytes",
+				len(v),
+				metadataMaxStringBytes,
+			)
+		}
+	case []any:
+		for _, item := range v {
+			if err := checkMetadatumLength(item); err != nil {
+				return err
+			}
+		}
+	case map[any]any:
+		for key, item := range v {
+			if err := checkMetadatumLength(key); err != nil {
+				return err
+			}
+			if err := checkMetadatumLength(item); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		for key, item := range v {
+			if err := checkMetadatumLength(key); err != nil {
+				return err
+			}
+			if err := checkMetadatumLength(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// UnmarshalYAML decodes the metadata from YAML, using the same fields as UnmarshalJSON
+func (c *Cip20Metadata) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYamlViaJson(node, c)
+}
+
+// MarshalYAML encodes the metadata as YAML, using the same fields as MarshalJSON
+func (c Cip20Metadata) MarshalYAML() (any, error) {
+	return marshalYamlViaJson(c)
+}
+
+func (c Cip20Metadata) String() string {
+	msgs := make([]string, len(c.Num674.Msg))
+	for idx, msg := range c.Num674.Msg {
+		msgs[idx] = strconv.Quote(msg)
+	}
+	ret := fmt.Sprintf("Cip20Metadata { Msg = [ %s ]", strings.Join(msgs, ", "))
+	if len(c.Num674.Extra) > 0 {
+		ret += fmt.Sprintf(", ExtraKeys = %d", len(c.Num674.Extra))
+	}
+	return ret + " }"
+}
+
+// LogValue summarizes the metadata for structured logging, without the message contents
+func (c Cip20Metadata) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("label", Cip20MetadataLabel),
+		slog.Int("messages", len(c.Num674.Msg)),
+		slog.Int("extraKeys", len(c.Num674.Extra)),
+	)
+}
+
 func (c *Cip20Metadata) Validate() error {
-	validate := validator.New()
-	return validate.Struct(c)
+	if c.Num674.Msg == nil && c.Num674.Extra == nil {
+		return fmt.Errorf("%w 674 in CIP-20 metadata", ErrMissingLabel)
+	}
+	return packageValidator().Struct(c)
+}
+
+func validateMaxBytes(fl validator.FieldLevel) bool {
+	if fl.Field().Kind() != reflect.String {
+		return false
+	}
+	maxBytes, err := strconv.Atoi(fl.Param())
+	if err != nil {
+		return false
+	}
+	return len(fl.Field().String()) <= maxBytes
+}
+
+// splitMetadataString splits a string into chunks that fit within the metadata string length
+// limit, without splitting any multi-byte UTF-8 characters
+func splitMetadataString(s string) []string {
+	var ret []string
+	for len(s) > 0 {
+		end := min(len(s), metadataMaxStringBytes)
+		for end < len(s) && end > 0 && !utf8.RuneStart(s[end]) {
+			end--
+		}
+		// Fall back to a plain byte split for invalid UTF-8
+		if end == 0 {
+			end = min(len(s), metadataMaxStringBytes)
+		}
+		ret = append(ret, s[:end])
+		s = s[end:]
+	}
+	return ret
 }
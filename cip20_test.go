@@ -1,6 +1,7 @@
 package models
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"reflect"
@@ -255,3 +256,264 @@ func TestNewCip20Metadata(t *testing.T) {
 		}
 	}
 }
+
+func TestCip20MetadataValidateByteLength(t *testing.T) {
+	t.Parallel()
+	// 22 three-byte characters is within the character limit but exceeds 64 bytes
+	metadata := Cip20Metadata{
+		Num674: Num674{
+			Msg: []string{strings.Repeat("€", 22)},
+		},
+	}
+	if err := metadata.Validate(); err == nil {
+		t.Errorf("expected validation error but got none")
+	}
+	metadata.Num674.Msg = []string{strings.Repeat("€", 21)}
+	if err := metadata.Validate(); err != nil {
+		t.Errorf("did not expect validation error but got one: %v", err)
+	}
+}
+
+func TestNewCip20MetadataFromText(t *testing.T) {
+	t.Parallel()
+	text := strings.Repeat("a", 63) + strings.Repeat("€", 2) + "bc"
+	expectedMsg := []string{
+		strings.Repeat("a", 63),
+		"€€bc",
+	}
+
+	metadata, err := NewCip20MetadataFromText(text)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !reflect.DeepEqual(metadata.Num674.Msg, expectedMsg) {
+		t.Errorf("Expected messages: %q, but got: %q", expectedMsg, metadata.Num674.Msg)
+	}
+	if strings.Join(metadata.Num674.Msg, "") != text {
+		t.Errorf("joined messages do not match original text")
+	}
+
+	// Test case: Empty text
+	if _, err := NewCip20MetadataFromText(""); err == nil {
+		t.Errorf("Expected validation error, but got no error")
+	}
+}
+
+func TestCip20MetadataExtraKeys(t *testing.T) {
+	t.Parallel()
+	cborHex := "a11902a2a3626964182a636d7367816f4f72646572203132333420706169646372656666616263313233"
+	jsonData := `{"674":{"id":42,"msg":["Order 1234 paid"],"ref":"abc123"}}`
+
+	// CBOR round-trip
+	cborData, err := hex.DecodeString(cborHex)
+	if err != nil {
+		t.Fatalf("failed to decode CBOR hex string: %v", err)
+	}
+	var cborMetadata Cip20Metadata
+	if err := cbor.Unmarshal(cborData, &cborMetadata); err != nil {
+		t.Fatalf("unexpected CBOR unmarshal error: %v", err)
+	}
+	expectedCborObj := Cip20Metadata{
+		Num674: Num674{
+			Msg: []string{"Order 1234 paid"},
+			Extra: map[string]any{
+				"id":  uint64(42),
+				"ref": "abc123",
+			},
+		},
+	}
+	if !reflect.DeepEqual(cborMetadata, expectedCborObj) {
+		t.Errorf("expected: %v, got: %v", expectedCborObj, cborMetadata)
+	}
+	encoded, err := cbor.Marshal(&cborMetadata)
+	if err != nil {
+		t.Fatalf("unexpected CBOR marshal error: %v", err)
+	}
+	if hex.EncodeToString(encoded) != cborHex {
+		t.Errorf("expected CBOR: %s, got: %x", cborHex, encoded)
+	}
+
+	// JSON round-trip
+	var jsonMetadata Cip20Metadata
+	if err := json.Unmarshal([]byte(jsonData), &jsonMetadata); err != nil {
+		t.Fatalf("unexpected JSON unmarshal error: %v", err)
+	}
+	expectedJsonObj := Cip20Metadata{
+		Num674: Num674{
+			Msg: []string{"Order 1234 paid"},
+			Extra: map[string]any{
+				"id":  int64(42),
+				"ref": "abc123",
+			},
+		},
+	}
+	if !reflect.DeepEqual(jsonMetadata, expectedJsonObj) {
+		t.Errorf("expected: %v, got: %v", expectedJsonObj, jsonMetadata)
+	}
+	if err := jsonMetadata.Validate(); err != nil {
+		t.Errorf("did not expect validation error but got one: %v", err)
+	}
+	encodedJson, err := json.Marshal(&jsonMetadata)
+	if err != nil {
+		t.Fatalf("unexpected JSON marshal error: %v", err)
+	}
+	if string(encodedJson) != jsonData {
+		t.Errorf("expected JSON: %s, got: %s", jsonData, encodedJson)
+	}
+}
+
+func TestCip20MetadataNestedExtraCborToJson(t *testing.T) {
+	t.Parallel()
+	// {674: {"msg": ["hi"], "meta": {1: h'abcd', "k": [1, 2]}}}
+	cborHex := "a11902a2a2636d736781626869646d657461a20142abcd616b820102"
+	cborData, err := hex.DecodeString(cborHex)
+	if err != nil {
+		t.Fatalf("failed to decode CBOR hex string: %v", err)
+	}
+	var metadata Cip20Metadata
+	if err := cbor.Unmarshal(cborData, &metadata); err != nil {
+		t.Fatalf("unexpected CBOR unmarshal error: %v", err)
+	}
+	jsonData, err := json.Marshal(&metadata)
+	if err != nil {
+		t.Fatalf("unexpected JSON marshal error: %v", err)
+	}
+	expectedJson := `{"674":{"meta":{"1":"0xabcd","k":[1,2]},"msg":["hi"]}}`
+	if string(jsonData) != expectedJson {
+		t.Errorf("expected JSON: %s, got: %s", expectedJson, jsonData)
+	}
+	// The CBOR encoding is unchanged
+	encoded, err := cbor.Marshal(&metadata)
+	if err != nil {
+		t.Fatalf("unexpected CBOR marshal error: %v", err)
+	}
+	if hex.EncodeToString(encoded) != cborHex {
+		t.Errorf("expected CBOR: %s, got: %x", cborHex, encoded)
+	}
+}
+
+func TestCip20MetadataMarshalCBORChunking(t *testing.T) {
+	t.Parallel()
+	metadata := Cip20Metadata{
+		Num674: Num674{
+			Msg: []string{"short", strings.Repeat("a", 70)},
+		},
+	}
+	if _, err := cbor.Marshal(&metadata); err == nil {
+		t.Errorf("expected CBOR marshal error but got none")
+	}
+	metadata.ChunkMessages()
+	expectedMsg := []string{"short", strings.Repeat("a", 64), "aaaaaa"}
+	if !reflect.DeepEqual(metadata.Num674.Msg, expectedMsg) {
+		t.Errorf("Expected messages: %q, but got: %q", expectedMsg, metadata.Num674.Msg)
+	}
+	cborData, err := cbor.Marshal(&metadata)
+	if err != nil {
+		t.Fatalf("unexpected CBOR marshal error: %v", err)
+	}
+	var decodedMetadata Cip20Metadata
+	if err := cbor.Unmarshal(cborData, &decodedMetadata); err != nil {
+		t.Fatalf("unexpected CBOR unmarshal error: %v", err)
+	}
+	if !reflect.DeepEqual(decodedMetadata, metadata) {
+		t.Errorf("expected: %v, got: %v", metadata, decodedMetadata)
+	}
+}
+
+func TestCip20MetadataMarshalCBORChunkOnEncode(t *testing.T) {
+	t.Parallel()
+	messages := []string{"short", strings.Repeat("a", 70)}
+	// Without the option, over-long messages are an error
+	metadata := Cip20Metadata{Num674: Num674{Msg: messages}}
+	if _, err := cbor.Marshal(&metadata); err == nil {
+		t.Errorf("expected CBOR marshal error but got none")
+	}
+	// With the option, they are chunked in the output only
+	metadata.ChunkOnEncode = true
+	cborData, err := cbor.Marshal(&metadata)
+	if err != nil {
+		t.Fatalf("unexpected CBOR marshal error: %v", err)
+	}
+	if !reflect.DeepEqual(metadata.Num674.Msg, messages) {
+		t.Errorf("messages were modified: %q", metadata.Num674.Msg)
+	}
+	var decodedMetadata Cip20Metadata
+	if err := cbor.Unmarshal(cborData, &decodedMetadata); err != nil {
+		t.Fatalf("unexpected CBOR unmarshal error: %v", err)
+	}
+	expectedMsg := []string{"short", strings.Repeat("a", 64), "aaaaaa"}
+	if !reflect.DeepEqual(decodedMetadata.Num674.Msg, expectedMsg) {
+		t.Errorf("Expected messages: %q, but got: %q", expectedMsg, decodedMetadata.Num674.Msg)
+	}
+}
+
+func TestCip20MetadataMarshalCBORValueAndPointer(t *testing.T) {
+	t.Parallel()
+	// The length check applies whether the metadata is encoded by value or by pointer
+	metadata := Cip20Metadata{Num674: Num674{Msg: []string{strings.Repeat("a", 70)}}}
+	if _, err := cbor.Marshal(metadata); err == nil {
+		t.Errorf("expected CBOR marshal error for value but got none")
+	}
+	if _, err := cbor.Marshal(&metadata); err == nil {
+		t.Errorf("expected CBOR marshal error for pointer but got none")
+	}
+	metadata.ChunkOnEncode = true
+	valueCbor, err := cbor.Marshal(metadata)
+	if err != nil {
+		t.Fatalf("unexpected CBOR marshal error: %v", err)
+	}
+	pointerCbor, err := cbor.Marshal(&metadata)
+	if err != nil {
+		t.Fatalf("unexpected CBOR marshal error: %v", err)
+	}
+	if !bytes.Equal(valueCbor, pointerCbor) {
+		t.Errorf("value and pointer encodings differ: %x, %x", valueCbor, pointerCbor)
+	}
+}
+
+func TestCip20MetadataMarshalCBORExtraLength(t *testing.T) {
+	t.Parallel()
+	longStr := strings.Repeat("a", 65)
+	testExtras := []map[string]any{
+		{"note": longStr},
+		{longStr: "value"},
+		{"note": []byte(longStr)},
+		{"note": []any{"ok", longStr}},
+		{"note": map[any]any{uint64(1): longStr}},
+		{"note": map[string]any{longStr: int64(1)}},
+	}
+	for _, extra := range testExtras {
+		for _, chunkOnEncode := range []bool{false, true} {
+			metadata := Cip20Metadata{
+				Num674:        Num674{Msg: []string{"hi"}, Extra: extra},
+				ChunkOnEncode: chunkOnEncode,
+			}
+			if _, err := cbor.Marshal(metadata); err == nil {
+				t.Errorf("expected CBOR marshal error for extra %v but got none", extra)
+			}
+		}
+	}
+	// Values within the limit are fine
+	metadata := Cip20Metadata{
+		Num674: Num674{
+			Msg:   []string{"hi"},
+			Extra: map[string]any{"note": strings.Repeat("a", 64)},
+		},
+	}
+	if _, err := cbor.Marshal(metadata); err != nil {
+		t.Errorf("unexpected CBOR marshal error: %v", err)
+	}
+}
+
+func TestCip20MetadataString(t *testing.T) {
+	metadata := Cip20Metadata{
+		Num674: Num674{
+			Msg:   []string{"Invoice 42", "Thanks!"},
+			Extra: map[string]any{"enc": "basic"},
+		},
+	}
+	expected := `Cip20Metadata { Msg = [ "Invoice 42", "Thanks!" ], ExtraKeys = 1 }`
+	if metadata.String() != expected {
+		t.Fatalf("did not get expected string\n  got:    %s\n  wanted: %s", metadata.String(), expected)
+	}
+}
@@ -17,9 +17,14 @@ package models
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
 	"strconv"
+	"strings"
 
-	"github.com/go-playground/validator/v10"
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"gopkg.in/yaml.v3"
 )
 
 // Cip27Metadata is the top-level container for royalties data under the "777" tag.
@@ -38,7 +43,11 @@ type Cip777 struct {
 	rateRaw *string
 
 	// 'addr' can be either a string or array of strings, so we wrap it in AddrField.
-	Addr AddrField `cbor:"addr" json:"addr" validate:"required"`
+	Addr AddrField `cbor:"addr" json:"addr" validate:"required,bech32addr"`
+
+	// PreserveLegacyPct re-emits the legacy "pct" field on encode if it was present in the
+	// decoded input, so that historical metadata re-encodes to match the original.
+	PreserveLegacyPct bool `cbor:"-" json:"-"`
 }
 
 func (c *Cip27Metadata) UnmarshalJSON(data []byte) error {
@@ -51,7 +60,7 @@ func (c *Cip27Metadata) UnmarshalJSON(data []byte) error {
 	// Verify the "777" key exists at the top level.
 	val, ok := raw["777"]
 	if !ok {
-		return errors.New(`missing "777" key in CIP-27 metadata`)
+		return fmt.Errorf("%w 777 in CIP-27 metadata", ErrMissingLabel)
 	}
 
 	// Unmarshal the contents of "777" into c.Num777.
@@ -66,6 +75,40 @@ func (c *Cip27Metadata) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// UnmarshalCBOR decodes on-chain CIP-27 metadata and validates it, mirroring UnmarshalJSON.
+func (c *Cip27Metadata) UnmarshalCBOR(data []byte) error {
+	var raw map[uint64]cbor.RawMessage
+	if _, err := cbor.Decode(data, &raw); err != nil {
+		return err
+	}
+
+	val, ok := raw[777]
+	if !ok {
+		return fmt.Errorf("%w 777 in CIP-27 metadata", ErrMissingLabel)
+	}
+
+	if _, err := cbor.Decode(val, &c.Num777); err != nil {
+		return err
+	}
+
+	return c.Validate()
+}
+
+// MarshalCBOR encodes the metadata under the 777 label.
+func (c *Cip27Metadata) MarshalCBOR() ([]byte, error) {
+	return cbor.Encode(map[uint64]any{777: &c.Num777})
+}
+
+// UnmarshalYAML decodes the metadata from YAML, using the same fields as UnmarshalJSON
+func (c *Cip27Metadata) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYamlViaJson(node, c)
+}
+
+// MarshalYAML encodes the metadata as YAML, using the same fields as MarshalJSON
+func (c Cip27Metadata) MarshalYAML() (any, error) {
+	return marshalYamlViaJson(c)
+}
+
 // UnmarshalJSON checks which field ("rate" or "pct") is present, giving precedence to "rate."
 func (c *Cip777) UnmarshalJSON(data []byte) error {
 	// Temporary structure for decoding both fields plus 'addr.'
@@ -79,39 +122,117 @@ func (c *Cip777) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	return c.setFields(raw.Pct, raw.Rate, raw.Addr)
+}
+
+// UnmarshalCBOR checks which field ("rate" or "pct") is present, giving precedence to "rate."
+func (c *Cip777) UnmarshalCBOR(data []byte) error {
+	var raw struct {
+		Pct  *string   `cbor:"pct"`
+		Rate *string   `cbor:"rate"`
+		Addr AddrField `cbor:"addr"`
+	}
+
+	if _, err := cbor.Decode(data, &raw); err != nil {
+		return err
+	}
+
+	return c.setFields(raw.Pct, raw.Rate, raw.Addr)
+}
+
+func (c *Cip777) setFields(pct *string, rate *string, addr AddrField) error {
 	switch {
-	case raw.Rate != nil:
-		c.Rate = *raw.Rate
-	case raw.Pct != nil:
-		c.Rate = *raw.Pct
+	case rate != nil:
+		c.Rate = *rate
+	case pct != nil:
+		c.Rate = *pct
 	default:
 		return errors.New("missing both 'rate' and 'pct' fields")
 	}
 
-	c.pctRaw = raw.Pct
-	c.rateRaw = raw.Rate
-	c.Addr = raw.Addr
+	c.pctRaw = pct
+	c.rateRaw = rate
+	c.Addr = addr
 	return nil
 }
 
-// MarshalJSON outputs "rate" as our canonical field.
+// MarshalJSON outputs "rate" as our canonical field, unless PreserveLegacyPct is set.
 func (c Cip777) MarshalJSON() ([]byte, error) {
-	// We only expose "rate" in the final JSON.
 	var out struct {
-		Rate string    `json:"rate"`
+		Rate *string   `json:"rate,omitempty"`
+		Pct  *string   `json:"pct,omitempty"`
 		Addr AddrField `json:"addr"`
 	}
-	out.Rate = c.Rate
+	out.Rate, out.Pct = c.rateFields()
 	out.Addr = c.Addr
 	return json.Marshal(out)
 }
 
+// MarshalCBOR outputs "rate" as our canonical field, unless PreserveLegacyPct is set.
+func (c *Cip777) MarshalCBOR() ([]byte, error) {
+	tmpMap := map[string]any{
+		"addr": &c.Addr,
+	}
+	rate, pct := c.rateFields()
+	if rate != nil {
+		tmpMap["rate"] = *rate
+	}
+	if pct != nil {
+		tmpMap["pct"] = *pct
+	}
+	return cbor.Encode(tmpMap)
+}
+
+// IsLegacyPct reports whether the rate was decoded from the legacy "pct" field.
+func (c Cip777) IsLegacyPct() bool {
+	return c.pctRaw != nil && c.rateRaw == nil
+}
+
+// rateFields returns the values to output for the "rate" and "pct" keys.
+func (c Cip777) rateFields() (*string, *string) {
+	rate := c.Rate
+	if !c.PreserveLegacyPct || c.pctRaw == nil {
+		return &rate, nil
+	}
+	if c.rateRaw == nil {
+		// Only the legacy field was present, so it carries the rate
+		return nil, &rate
+	}
+	pct := *c.pctRaw
+	return &rate, &pct
+}
+
+// RateRat returns the royalty rate as an exact rational number, avoiding the precision loss of
+// parsing it as a float.
+func (c Cip777) RateRat() (*big.Rat, error) {
+	rate, ok := new(big.Rat).SetString(c.Rate)
+	if !ok {
+		return nil, fmt.Errorf("invalid rate: %q", c.Rate)
+	}
+	return rate, nil
+}
+
+// RateBasisPoints returns the royalty rate in basis points (1/100th of a percent), rounded down.
+func (c Cip777) RateBasisPoints() (int64, error) {
+	rate, err := c.RateRat()
+	if err != nil {
+		return 0, err
+	}
+	bps := new(big.Rat).Mul(rate, big.NewRat(10000, 1))
+	ret := new(big.Int).Quo(bps.Num(), bps.Denom())
+	if !ret.IsInt64() {
+		return 0, fmt.Errorf("rate out of range: %q", c.Rate)
+	}
+	return ret.Int64(), nil
+}
+
 // AddrField supports either a single string or an array of strings in JSON.
 type AddrField struct {
 	Addresses []string
 }
 
 // UnmarshalJSON attempts to parse 'addr' as a single string; if that fails, it tries an array of strings.
+// Array elements are kept as-is, as with UnmarshalCBOR; use Address to get a chunked address.
 func (af *AddrField) UnmarshalJSON(data []byte) error {
 	var single string
 	if err := json.Unmarshal(data, &single); err == nil {
@@ -136,6 +257,78 @@ func (af AddrField) MarshalJSON() ([]byte, error) {
 	return json.Marshal(af.Addresses)
 }
 
+// IsChunked reports whether the addresses look like a single address split into chunks to fit
+// the 64-byte metadata string limit, rather than multiple addresses. This is the case when every
+// element except the last is exactly 64 bytes and only the first has an address prefix.
+func (af AddrField) IsChunked() bool {
+	if len(af.Addresses) < 2 {
+		return false
+	}
+	for idx, addr := range af.Addresses {
+		if idx < len(af.Addresses)-1 && len(addr) != metadataMaxStringBytes {
+			return false
+		}
+		if (idx == 0) != strings.HasPrefix(addr, "addr") {
+			return false
+		}
+	}
+	return true
+}
+
+// Address returns the royalty address, joining the elements if they are chunks of a single
+// address. If multiple distinct addresses are present, the first is returned.
+func (af AddrField) Address() string {
+	if af.IsChunked() {
+		return strings.Join(af.Addresses, "")
+	}
+	if len(af.Addresses) == 0 {
+		return ""
+	}
+	return af.Addresses[0]
+}
+
+// JoinChunks replaces the addresses with a single address made by joining them, for callers that
+// know the elements are chunks of one address regardless of what IsChunked reports.
+func (af *AddrField) JoinChunks() {
+	if len(af.Addresses) < 2 {
+		return
+	}
+	af.Addresses = []string{strings.Join(af.Addresses, "")}
+}
+
+// UnmarshalCBOR parses 'addr' as a single string or, per the spec, an array of string chunks
+// that together make up a single address longer than the 64-byte metadata string limit. Array
+// elements are kept as-is, as with UnmarshalJSON; use Address to get the joined address.
+func (af *AddrField) UnmarshalCBOR(data []byte) error {
+	var single string
+	if _, err := cbor.Decode(data, &single); err == nil {
+		af.Addresses = []string{single}
+		return nil
+	}
+
+	var arr []string
+	if _, err := cbor.Decode(data, &arr); err == nil {
+		af.Addresses = arr
+		return nil
+	}
+
+	return errors.New("addr must be a string or an array of strings")
+}
+
+// MarshalCBOR returns 'addr' as a single string, splitting it into an array of chunks if it
+// exceeds the 64-byte metadata string limit. Chunks of a single address are re-chunked from the
+// joined address.
+func (af *AddrField) MarshalCBOR() ([]byte, error) {
+	if len(af.Addresses) != 1 && !af.IsChunked() {
+		return nil, errors.New("CIP-27 CBOR encoding requires exactly one address")
+	}
+	addr := af.Address()
+	if len(addr) <= metadataMaxStringBytes {
+		return cbor.Encode(addr)
+	}
+	return cbor.Encode(splitMetadataString(addr))
+}
+
 // NewCip27Metadata creates a new CIP-027 metadata object with the given rate and addresses.
 func NewCip27Metadata(rate string, addresses []string) (*Cip27Metadata, error) {
 	meta := &Cip27Metadata{
@@ -150,10 +343,26 @@ func NewCip27Metadata(rate string, addresses []string) (*Cip27Metadata, error) {
 	return meta, nil
 }
 
+func (c Cip27Metadata) String() string {
+	return fmt.Sprintf(
+		"Cip27Metadata { Rate = %s, Addr = [ %s ] }",
+		c.Num777.Rate,
+		strings.Join(c.Num777.Addr.royaltyAddresses(), ", "),
+	)
+}
+
+// LogValue summarizes the metadata for structured logging
+func (c Cip27Metadata) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("label", Cip27MetadataLabel),
+		slog.String("rate", c.Num777.Rate),
+		slog.Int("addresses", len(c.Num777.Addr.royaltyAddresses())),
+	)
+}
+
 // Validate checks that Rate is within [0..1] and there's at least one address.
 func (c *Cip27Metadata) Validate() error {
-	validate := validator.New()
-	if err := validate.Struct(c); err != nil {
+	if err := packageValidator().Struct(c); err != nil {
 		return err
 	}
 	val, err := strconv.ParseFloat(c.Num777.Rate, 64)
@@ -166,5 +375,197 @@ func (c *Cip27Metadata) Validate() error {
 	if len(c.Num777.Addr.Addresses) == 0 {
 		return errors.New("at least one address is required")
 	}
+	for _, addr := range c.Num777.Addr.royaltyAddresses() {
+		if _, err := parseCip27Address(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateNetwork performs the same checks as Validate and also checks that each address belongs
+// to the given network ID (0 for testnets, 1 for mainnet).
+func (c *Cip27Metadata) ValidateNetwork(networkId uint8) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	for _, addr := range c.Num777.Addr.royaltyAddresses() {
+		// The address has already been validated above
+		header, _ := parseCip27Address(addr)
+		if header&shelleyAddressNetworkMask != networkId {
+			return fmt.Errorf(
+				"address %s does not belong to network %d",
+				addr,
+				networkId,
+			)
+		}
+	}
+	return nil
+}
+
+// royaltyAddresses returns the addresses to validate, joining them first if they are chunks of a
+// single address.
+func (af AddrField) royaltyAddresses() []string {
+	if af.IsChunked() {
+		return []string{af.Address()}
+	}
+	return af.Addresses
+}
+
+// parseCip27Address checks that addr is a structurally valid Shelley bech32 payment address and
+// returns its header byte.
+func parseCip27Address(addr string) (byte, error) {
+	header, err := parseShelleyAddress(addr)
+	if err != nil {
+		return 0, err
+	}
+	// Only Shelley payment addresses (base, pointer and enterprise) can receive royalties
+	if header&shelleyAddressTypeMask > shelleyAddressTypeMaxPayment {
+		return 0, fmt.Errorf("invalid address %s: unsupported address type", addr)
+	}
+	return header, nil
+}
+
+// Cip27ExtendedRoyalty represents royalty info that splits the total rate between multiple
+// recipients, as used by marketplaces that extend the 777 label.
+type Cip27ExtendedRoyalty struct {
+	// Rate is the total royalty rate as a numeric string (e.g., "0.05").
+	Rate   string              `cbor:"rate" json:"rate"`
+	Splits []Cip27RoyaltySplit `cbor:"splits" json:"splits"`
+}
+
+// Cip27RoyaltySplit is a single recipient's share of an extended royalty.
+type Cip27RoyaltySplit struct {
+	Addr string `cbor:"addr" json:"addr"`
+	// Rate is the recipient's portion of the total rate as a numeric string.
+	Rate string `cbor:"rate" json:"rate"`
+}
+
+// NewCip27ExtendedRoyalty creates extended royalty info from the given splits, using their sum as
+// the total rate.
+func NewCip27ExtendedRoyalty(splits []Cip27RoyaltySplit) (*Cip27ExtendedRoyalty, error) {
+	total := new(big.Rat)
+	for _, split := range splits {
+		rate, ok := new(big.Rat).SetString(split.Rate)
+		if !ok {
+			return nil, fmt.Errorf("invalid split rate: %q", split.Rate)
+		}
+		total.Add(total, rate)
+	}
+	ret := &Cip27ExtendedRoyalty{
+		Rate:   total.FloatString(cip27RatePrecision(splits)),
+		Splits: splits,
+	}
+	if err := ret.Validate(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Validate checks that the total rate is within [0..1], that each split has a valid address and a
+// non-negative rate, and that the splits sum to the total rate.
+func (e *Cip27ExtendedRoyalty) Validate() error {
+	total, ok := new(big.Rat).SetString(e.Rate)
+	if !ok {
+		return errors.New("rate must be a valid number")
+	}
+	if total.Sign() < 0 || total.Cmp(big.NewRat(1, 1)) > 0 {
+		return errors.New("rate must be between 0.0 and 1.0")
+	}
+	if len(e.Splits) == 0 {
+		return errors.New("at least one split is required")
+	}
+	sum := new(big.Rat)
+	for _, split := range e.Splits {
+		if _, err := parseCip27Address(split.Addr); err != nil {
+			return err
+		}
+		rate, ok := new(big.Rat).SetString(split.Rate)
+		if !ok {
+			return fmt.Errorf("invalid split rate: %q", split.Rate)
+		}
+		if rate.Sign() < 0 {
+			return fmt.Errorf("split rate must not be negative: %q", split.Rate)
+		}
+		sum.Add(sum, rate)
+	}
+	if sum.Cmp(total) != 0 {
+		return fmt.Errorf(
+			"split rates sum to %s, which does not match the total rate %s",
+			sum.RatString(),
+			total.RatString(),
+		)
+	}
 	return nil
 }
+
+// NewCip27ExtendedRoyaltyFromCip27 creates extended royalty info with a single split from plain
+// CIP-27 metadata.
+func NewCip27ExtendedRoyaltyFromCip27(meta *Cip27Metadata) *Cip27ExtendedRoyalty {
+	return &Cip27ExtendedRoyalty{
+		Rate: meta.Num777.Rate,
+		Splits: []Cip27RoyaltySplit{
+			{
+				Addr: meta.Num777.Addr.Address(),
+				Rate: meta.Num777.Rate,
+			},
+		},
+	}
+}
+
+// ToCip27 converts to plain CIP-27 metadata. This is only possible with a single recipient, since
+// plain CIP-27 has no way to express per-address rates.
+func (e *Cip27ExtendedRoyalty) ToCip27() (*Cip27Metadata, error) {
+	if len(e.Splits) != 1 {
+		return nil, errors.New("plain CIP-27 metadata supports only a single recipient")
+	}
+	return NewCip27Metadata(e.Rate, []string{e.Splits[0].Addr})
+}
+
+// ToCip102 converts to a CIP-102 royalty datum. CIP-102 encodes each rate as 10 divided by the
+// rate, which is rounded down, so the resulting rates may be slightly higher than the originals.
+func (e *Cip27ExtendedRoyalty) ToCip102() (*NebulaRoyaltyInfo, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	ret := &NebulaRoyaltyInfo{
+		Version: nebulaRoyaltyInfoVersion,
+		// Empty constructor for the extra data
+		Extra: cbor.RawMessage{0xd8, 0x79, 0x80},
+	}
+	for _, split := range e.Splits {
+		addr, err := NewPlutusAddressFromBech32(split.Addr)
+		if err != nil {
+			return nil, err
+		}
+		// The rate has already been validated above
+		rate, _ := new(big.Rat).SetString(split.Rate)
+		if rate.Sign() == 0 {
+			return nil, fmt.Errorf("cannot represent a zero rate for %s in CIP-102", split.Addr)
+		}
+		fee := new(big.Rat).Quo(big.NewRat(10, 1), rate)
+		feeInt := new(big.Int).Quo(fee.Num(), fee.Denom())
+		if !feeInt.IsInt64() {
+			return nil, fmt.Errorf("rate for %s is too small for CIP-102", split.Addr)
+		}
+		ret.Recipients = append(
+			ret.Recipients,
+			NebulaRoyaltyRecipient{
+				Address: addr,
+				Fee:     feeInt.Int64(),
+			},
+		)
+	}
+	return ret, nil
+}
+
+// cip27RatePrecision returns the largest number of decimal places used by the split rates.
+func cip27RatePrecision(splits []Cip27RoyaltySplit) int {
+	var ret int
+	for _, split := range splits {
+		if idx := strings.IndexByte(split.Rate, '.'); idx >= 0 {
+			ret = max(ret, len(split.Rate)-idx-1)
+		}
+	}
+	return ret
+}
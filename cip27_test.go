@@ -15,23 +15,33 @@
 package models
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"math/big"
 	"reflect"
 	"testing"
 
+	"github.com/blinklabs-io/gouroboros/cbor"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	testCip27Address        = "addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9un"
+	testCip27BaseAddress    = "addr1qydzk0zdtehhpqvj5w6vt4h8lqy352euf40x7uypj23mf327dacgry4rknzadelcpydzk0zdtehhpqvj5w6vt4h8lqyseqgujn"
+	testCip27TestnetAddress = "addr_test1vqdzk0zdtehhpqvj5w6vt4h8lqy352euf40x7uypj23mf3grnv4hu"
 )
 
 func TestNewCip27Metadata_Success(t *testing.T) {
 	// Single address, valid rate.
-	meta, err := NewCip27Metadata("0.25", []string{"addr1xy..."})
+	meta, err := NewCip27Metadata("0.25", []string{testCip27Address})
 	require.NoError(t, err)
 	require.Equal(t, "0.25", meta.Num777.Rate)
 	require.Len(t, meta.Num777.Addr.Addresses, 1)
 }
 
 func TestNewCip27Metadata_MultipleAddresses(t *testing.T) {
-	addrs := []string{"addr1abc", "addr2def"}
+	addrs := []string{testCip27Address, testCip27BaseAddress}
 	meta, err := NewCip27Metadata("0.25", addrs)
 	require.NoError(t, err)
 	require.True(t, reflect.DeepEqual(addrs, meta.Num777.Addr.Addresses))
@@ -39,19 +49,19 @@ func TestNewCip27Metadata_MultipleAddresses(t *testing.T) {
 
 func TestRateBoundaries(t *testing.T) {
 	// Valid boundary
-	_, err := NewCip27Metadata("1.0", []string{"addr1..."})
+	_, err := NewCip27Metadata("1.0", []string{testCip27Address})
 	require.NoError(t, err)
 
 	// Out-of-range: 1.1
-	_, err = NewCip27Metadata("1.1", []string{"addr1..."})
+	_, err = NewCip27Metadata("1.1", []string{testCip27Address})
 	require.Error(t, err)
 
 	// Negative
-	_, err = NewCip27Metadata("-0.1", []string{"addr1..."})
+	_, err = NewCip27Metadata("-0.1", []string{testCip27Address})
 	require.Error(t, err)
 
 	// Not a float
-	_, err = NewCip27Metadata("abc", []string{"addr1..."})
+	_, err = NewCip27Metadata("abc", []string{testCip27Address})
 	require.Error(t, err)
 }
 
@@ -59,21 +69,21 @@ func TestUnmarshal_LegacyPct(t *testing.T) {
 	input := `{
       "777": {
         "pct": "0.125",
-        "addr": "addr1legacy..."
+        "addr": "addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9un"
       }
     }`
 	var meta Cip27Metadata
 	err := json.Unmarshal([]byte(input), &meta)
 	require.NoError(t, err)
 	require.Equal(t, "0.125", meta.Num777.Rate)
-	require.Equal(t, "addr1legacy...", meta.Num777.Addr.Addresses[0])
+	require.Equal(t, testCip27Address, meta.Num777.Addr.Addresses[0])
 }
 
 func TestUnmarshal_Rate(t *testing.T) {
 	input := `{
       "777": {
         "rate": "0.20",
-        "addr": ["addr1qmodern","addr2other"]
+        "addr": ["addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9un","addr1qydzk0zdtehhpqvj5w6vt4h8lqy352euf40x7uypj23mf327dacgry4rknzadelcpydzk0zdtehhpqvj5w6vt4h8lqyseqgujn"]
       }
     }`
 	var meta Cip27Metadata
@@ -89,14 +99,14 @@ func TestUnmarshal_BothPctAndRate(t *testing.T) {
       "777": {
         "pct": "0.100",
         "rate": "0.200",
-        "addr": "addr1override"
+        "addr": "addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9un"
       }
     }`
 	var meta Cip27Metadata
 	err := json.Unmarshal([]byte(input), &meta)
 	require.NoError(t, err)
 	require.Equal(t, "0.200", meta.Num777.Rate)
-	require.Equal(t, "addr1override", meta.Num777.Addr.Addresses[0])
+	require.Equal(t, testCip27Address, meta.Num777.Addr.Addresses[0])
 }
 
 func TestUnmarshal_MissingPctRate(t *testing.T) {
@@ -270,7 +280,7 @@ func TestUnmarshal_BothPctAndRate_RateEmpty(t *testing.T) {
 
 func TestCip27Metadata_MarshalJSON(t *testing.T) {
 	// Create a CIP-27 metadata object.
-	meta, err := NewCip27Metadata("0.25", []string{"addr1xy...", "addr2zzz"})
+	meta, err := NewCip27Metadata("0.25", []string{testCip27Address, testCip27BaseAddress})
 	require.NoError(t, err, "Should create CIP-27 metadata without error")
 
 	data, err := json.Marshal(meta)
@@ -292,6 +302,326 @@ func TestCip27Metadata_MarshalJSON(t *testing.T) {
 	addrs, ok := topLevel["addr"].([]interface{})
 	require.True(t, ok, "Should have an array of addresses")
 	require.Len(t, addrs, 2, "Should have exactly 2 addresses")
-	require.Equal(t, "addr1xy...", addrs[0])
-	require.Equal(t, "addr2zzz", addrs[1])
+	require.Equal(t, testCip27Address, addrs[0])
+	require.Equal(t, testCip27BaseAddress, addrs[1])
+}
+
+func TestCip27Metadata_CborRoundTrip_ChunkedAddress(t *testing.T) {
+	cborHex := "a1190309a2646164647282784061646472317179647a6b307a64746568687071766a35773676743468386c7179333532657566343078377579706a32336d6633323764616367727934726b6e7a78276164656c637079647a6b307a64746568687071766a35773676743468386c717973657167756a6e647261746564302e3035"
+	addr := "addr1qydzk0zdtehhpqvj5w6vt4h8lqy352euf40x7uypj23mf327dacgry4rknzadelcpydzk0zdtehhpqvj5w6vt4h8lqyseqgujn"
+	cborData, err := hex.DecodeString(cborHex)
+	require.NoError(t, err)
+
+	var meta Cip27Metadata
+	_, err = cbor.Decode(cborData, &meta)
+	require.NoError(t, err)
+	require.Equal(t, "0.05", meta.Num777.Rate)
+	require.Equal(t, []string{addr[:64], addr[64:]}, meta.Num777.Addr.Addresses)
+	require.True(t, meta.Num777.Addr.IsChunked())
+	require.Equal(t, addr, meta.Num777.Addr.Address())
+
+	encoded, err := cbor.Encode(&meta)
+	require.NoError(t, err)
+	require.Equal(t, cborHex, hex.EncodeToString(encoded))
+
+	// A single joined address is chunked on encode
+	meta.Num777.Addr.JoinChunks()
+	encoded, err = cbor.Encode(&meta)
+	require.NoError(t, err)
+	require.Equal(t, cborHex, hex.EncodeToString(encoded))
+}
+
+func TestCip27Metadata_JsonToCbor_ChunkedAddress(t *testing.T) {
+	addr := "addr1qydzk0zdtehhpqvj5w6vt4h8lqy352euf40x7uypj23mf327dacgry4rknzadelcpydzk0zdtehhpqvj5w6vt4h8lqyseqgujn"
+	input := `{"777":{"rate":"0.05","addr":["` + addr[:64] + `","` + addr[64:] + `"]}}`
+	var meta Cip27Metadata
+	require.NoError(t, json.Unmarshal([]byte(input), &meta))
+	require.NoError(t, meta.Validate())
+	encoded, err := cbor.Encode(&meta)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"a1190309a2646164647282784061646472317179647a6b307a64746568687071766a35773676743468386c7179333532657566343078377579706a32336d6633323764616367727934726b6e7a78276164656c637079647a6b307a64746568687071766a35773676743468386c717973657167756a6e647261746564302e3035",
+		hex.EncodeToString(encoded),
+	)
+	var decoded Cip27Metadata
+	_, err = cbor.Decode(encoded, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, meta.Num777.Addr, decoded.Num777.Addr)
+	jsonData, err := json.Marshal(&decoded)
+	require.NoError(t, err)
+	require.JSONEq(t, input, string(jsonData))
+}
+
+func TestCip27Metadata_CborLegacyPct(t *testing.T) {
+	// {777: {"pct": "0.2", "addr": "addr_test1..."}}
+	cborData, err := hex.DecodeString("a1190309a26370637463302e326461646472783f616464725f74657374317671647a6b307a64746568687071766a35773676743468386c7179333532657566343078377579706a32336d663367726e76346875")
+	require.NoError(t, err)
+
+	var meta Cip27Metadata
+	_, err = cbor.Decode(cborData, &meta)
+	require.NoError(t, err)
+	require.Equal(t, "0.2", meta.Num777.Rate)
+	require.Equal(t, []string{"addr_test1vqdzk0zdtehhpqvj5w6vt4h8lqy352euf40x7uypj23mf3grnv4hu"}, meta.Num777.Addr.Addresses)
+
+	// Re-encoding uses the canonical "rate" key
+	encoded, err := cbor.Encode(&meta)
+	require.NoError(t, err)
+	var decoded map[uint64]map[string]any
+	_, err = cbor.Decode(encoded, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, "0.2", decoded[777]["rate"])
+	require.NotContains(t, decoded[777], "pct")
+}
+
+func TestCip27Metadata_CborMultipleAddresses(t *testing.T) {
+	meta := Cip27Metadata{
+		Num777: Cip777{
+			Rate: "0.05",
+			Addr: AddrField{Addresses: []string{"addr1abc", "addr1def"}},
+		},
+	}
+	_, err := cbor.Encode(&meta)
+	require.Error(t, err)
+}
+
+func TestCip27Metadata_CborMissing777(t *testing.T) {
+	// {674: {}}
+	cborData, err := hex.DecodeString("a11902a2a0")
+	require.NoError(t, err)
+	var meta Cip27Metadata
+	_, err = cbor.Decode(cborData, &meta)
+	require.Error(t, err)
+}
+
+func TestAddrField_Chunked(t *testing.T) {
+	chunks := AddrField{
+		Addresses: []string{
+			"addr1q8g3dv6ptkgsafh7k5muggrvfde2szzmc2mqkcxpxn7c63l9znc9e3xa82h",
+			"pf39scc37tcu9ggy0l89gy2f9r2lf7husfvu8wh",
+		},
+	}
+	joined := "addr1q8g3dv6ptkgsafh7k5muggrvfde2szzmc2mqkcxpxn7c63l9znc9e3xa82hpf39scc37tcu9ggy0l89gy2f9r2lf7husfvu8wh"
+	require.True(t, chunks.IsChunked())
+	require.Equal(t, joined, chunks.Address())
+	chunks.JoinChunks()
+	require.Equal(t, []string{joined}, chunks.Addresses)
+	require.False(t, chunks.IsChunked())
+	require.Equal(t, joined, chunks.Address())
+}
+
+func TestAddrField_NotChunked(t *testing.T) {
+	multiple := AddrField{Addresses: []string{"addr1abc", "addr1def"}}
+	require.False(t, multiple.IsChunked())
+	require.Equal(t, "addr1abc", multiple.Address())
+
+	// Full-length elements that each have an address prefix are separate addresses
+	multiple = AddrField{
+		Addresses: []string{
+			"addr1q8g3dv6ptkgsafh7k5muggrvfde2szzmc2mqkcxpxn7c63l9znc9e3xa82h",
+			"addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9un",
+		},
+	}
+	require.False(t, multiple.IsChunked())
+
+	require.Equal(t, "", AddrField{}.Address())
+}
+
+func TestCip27Metadata_ValidateAddress(t *testing.T) {
+	// Valid base, enterprise and testnet addresses
+	for _, addr := range []string{testCip27Address, testCip27BaseAddress, testCip27TestnetAddress} {
+		_, err := NewCip27Metadata("0.05", []string{addr})
+		require.NoError(t, err, addr)
+	}
+
+	invalidAddrs := []string{
+		// Placeholder text
+		"addr1xy...",
+		// Bad checksum
+		"addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9uq",
+		// Mainnet address with testnet prefix
+		"addr_test1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq0hugn3",
+		// Stake address
+		"stake1u90x7uypj23mf3wkuluqjx3t83x4ummssxf28dx96mnlszgfyufzq",
+	}
+	for _, addr := range invalidAddrs {
+		_, err := NewCip27Metadata("0.05", []string{addr})
+		require.Error(t, err, addr)
+	}
+}
+
+func TestCip27Metadata_ValidateNetwork(t *testing.T) {
+	meta, err := NewCip27Metadata("0.05", []string{testCip27Address})
+	require.NoError(t, err)
+	require.NoError(t, meta.ValidateNetwork(1))
+	require.Error(t, meta.ValidateNetwork(0))
+
+	meta, err = NewCip27Metadata("0.05", []string{testCip27TestnetAddress})
+	require.NoError(t, err)
+	require.NoError(t, meta.ValidateNetwork(0))
+	require.Error(t, meta.ValidateNetwork(1))
+}
+
+func TestCip777_RateAccessors(t *testing.T) {
+	testDefs := []struct {
+		rate        string
+		expectedRat *big.Rat
+		expectedBps int64
+	}{
+		{rate: "0.2", expectedRat: big.NewRat(1, 5), expectedBps: 2000},
+		{rate: "0.125", expectedRat: big.NewRat(1, 8), expectedBps: 1250},
+		{rate: "0.07", expectedRat: big.NewRat(7, 100), expectedBps: 700},
+		// Sub-basis-point rates round down
+		{rate: "0.00015", expectedRat: big.NewRat(3, 20000), expectedBps: 1},
+	}
+	for _, testDef := range testDefs {
+		rate := Cip777{Rate: testDef.rate}
+		rat, err := rate.RateRat()
+		require.NoError(t, err, testDef.rate)
+		require.Equal(t, 0, rat.Cmp(testDef.expectedRat), testDef.rate)
+		bps, err := rate.RateBasisPoints()
+		require.NoError(t, err, testDef.rate)
+		require.Equal(t, testDef.expectedBps, bps, testDef.rate)
+	}
+
+	_, err := Cip777{Rate: "abc"}.RateRat()
+	require.Error(t, err)
+	_, err = Cip777{Rate: ""}.RateBasisPoints()
+	require.Error(t, err)
+}
+
+func TestCip777_PreserveLegacyPct(t *testing.T) {
+	input := `{"777":{"pct":"0.125","addr":"` + testCip27Address + `"}}`
+	var meta Cip27Metadata
+	require.NoError(t, json.Unmarshal([]byte(input), &meta))
+	require.True(t, meta.Num777.IsLegacyPct())
+
+	// The canonical "rate" key is used by default
+	data, err := json.Marshal(&meta)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"777":{"rate":"0.125","addr":"`+testCip27Address+`"}}`, string(data))
+
+	meta.Num777.PreserveLegacyPct = true
+	data, err = json.Marshal(&meta)
+	require.NoError(t, err)
+	require.JSONEq(t, input, string(data))
+
+	// The legacy key is also preserved through CBOR
+	cborData, err := cbor.Encode(&meta)
+	require.NoError(t, err)
+	var decoded map[uint64]map[string]any
+	_, err = cbor.Decode(cborData, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, "0.125", decoded[777]["pct"])
+	require.NotContains(t, decoded[777], "rate")
+}
+
+func TestCip777_PreserveLegacyPct_BothKeys(t *testing.T) {
+	input := `{"777":{"pct":"0.100","rate":"0.200","addr":"` + testCip27Address + `"}}`
+	var meta Cip27Metadata
+	require.NoError(t, json.Unmarshal([]byte(input), &meta))
+	require.False(t, meta.Num777.IsLegacyPct())
+	meta.Num777.PreserveLegacyPct = true
+	data, err := json.Marshal(&meta)
+	require.NoError(t, err)
+	require.JSONEq(t, input, string(data))
+}
+
+func TestCip27ExtendedRoyalty(t *testing.T) {
+	royalty, err := NewCip27ExtendedRoyalty(
+		[]Cip27RoyaltySplit{
+			{Addr: testCip27Address, Rate: "0.03"},
+			{Addr: testCip27BaseAddress, Rate: "0.016"},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "0.046", royalty.Rate)
+
+	// Plain CIP-27 can't express multiple recipients
+	_, err = royalty.ToCip27()
+	require.Error(t, err)
+
+	info, err := royalty.ToCip102()
+	require.NoError(t, err)
+	require.Len(t, info.Recipients, 2)
+	// 10 / 0.03 = 333.33, rounded down
+	require.Equal(t, int64(333), info.Recipients[0].Fee)
+	require.Equal(t, int64(625), info.Recipients[1].Fee)
+	expectedAddr, err := NewPlutusAddressFromBech32(testCip27BaseAddress)
+	require.NoError(t, err)
+	require.Equal(t, expectedAddr, info.Recipients[1].Address)
+	_, err = cbor.Encode(info)
+	require.NoError(t, err)
+}
+
+func TestCip27ExtendedRoyalty_Cip27RoundTrip(t *testing.T) {
+	meta, err := NewCip27Metadata("0.05", []string{testCip27Address})
+	require.NoError(t, err)
+	royalty := NewCip27ExtendedRoyaltyFromCip27(meta)
+	require.NoError(t, royalty.Validate())
+	require.Equal(t, []Cip27RoyaltySplit{{Addr: testCip27Address, Rate: "0.05"}}, royalty.Splits)
+	converted, err := royalty.ToCip27()
+	require.NoError(t, err)
+	require.Equal(t, meta.Num777.Rate, converted.Num777.Rate)
+	require.Equal(t, meta.Num777.Addr, converted.Num777.Addr)
+}
+
+func TestCip27ExtendedRoyalty_Validate(t *testing.T) {
+	testDefs := []Cip27ExtendedRoyalty{
+		// Splits don't sum to the total
+		{
+			Rate:   "0.05",
+			Splits: []Cip27RoyaltySplit{{Addr: testCip27Address, Rate: "0.04"}},
+		},
+		// Negative split
+		{
+			Rate: "0.05",
+			Splits: []Cip27RoyaltySplit{
+				{Addr: testCip27Address, Rate: "0.06"},
+				{Addr: testCip27BaseAddress, Rate: "-0.01"},
+			},
+		},
+		// Invalid address
+		{
+			Rate:   "0.05",
+			Splits: []Cip27RoyaltySplit{{Addr: "addr1xy...", Rate: "0.05"}},
+		},
+		// No splits
+		{
+			Rate: "0.05",
+		},
+		// Total out of range
+		{
+			Rate:   "1.5",
+			Splits: []Cip27RoyaltySplit{{Addr: testCip27Address, Rate: "1.5"}},
+		},
+	}
+	for _, testDef := range testDefs {
+		require.Error(t, testDef.Validate(), "%#v", testDef)
+	}
+}
+
+func TestCip27MetadataString(t *testing.T) {
+	metadata, err := NewCip27Metadata("0.2", []string{testCip27Address})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"Cip27Metadata { Rate = 0.2, Addr = [ "+testCip27Address+" ] }",
+		metadata.String(),
+	)
+}
+
+func TestCip27MetadataYaml(t *testing.T) {
+	testYaml := "777:\n  pct: \"0.2\"\n  addr:\n    - " + testCip27Address + "\n"
+	var metadata Cip27Metadata
+	require.NoError(t, yaml.Unmarshal([]byte(testYaml), &metadata))
+	require.Equal(t, "0.2", metadata.Num777.Rate)
+	require.Equal(t, []string{testCip27Address}, metadata.Num777.Addr.Addresses)
+	yamlData, err := yaml.Marshal(metadata)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"\"777\":\n    addr: "+testCip27Address+"\n    rate: \"0.2\"\n",
+		string(yamlData),
+	)
 }
@@ -0,0 +1,181 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Transaction metadata labels used by CIP-36 deregistrations
+const (
+	Cip36WitnessMetadataLabel        = 61285
+	Cip36DeregistrationMetadataLabel = 61286
+)
+
+// Cip36DeregistrationMetadata represents the metadata attached to a CIP-36 (Catalyst) vote
+// deregistration, which consists of the deregistration itself and a witness signed by the
+// stake key
+type Cip36DeregistrationMetadata struct {
+	Num61286 Cip36Deregistration `cbor:"61286,keyasint" validate:"required"`
+	Num61285 Cip36Witness        `cbor:"61285,keyasint" validate:"required"`
+
+	// The original CBOR for the deregistration and its decoded value, which are used to encode
+	// and sign the deregistration exactly as it appeared on chain until it's modified
+	rawDeregistration     []byte
+	decodedDeregistration Cip36Deregistration
+}
+
+// Cip36Deregistration identifies the stake key whose voting power is being deregistered
+type Cip36Deregistration struct {
+	// StakePublicKey is the ed25519 public key of the stake credential
+	StakePublicKey []byte `cbor:"1,keyasint" validate:"len=32"`
+	// Nonce is used to order registrations and deregistrations, and is usually the current slot
+	Nonce uint64 `cbor:"2,keyasint"`
+	// VotingPurpose is 0 for Catalyst
+	VotingPurpose uint64 `cbor:"3,keyasint"`
+}
+
+func (c Cip36Deregistration) equal(other Cip36Deregistration) bool {
+	return bytes.Equal(c.StakePublicKey, other.StakePublicKey) &&
+		c.Nonce == other.Nonce &&
+		c.VotingPurpose == other.VotingPurpose
+}
+
+// Cip36Witness contains the stake key signature over the registration or deregistration
+type Cip36Witness struct {
+	Signature []byte `cbor:"1,keyasint" validate:"len=64"`
+}
+
+// NewCip36DeregistrationMetadata creates deregistration metadata for the stake key, signed with
+// the provided private key
+func NewCip36DeregistrationMetadata(
+	stakeKey ed25519.PrivateKey,
+	nonce uint64,
+	votingPurpose uint64,
+) (*Cip36DeregistrationMetadata, error) {
+	if len(stakeKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key length: %d", len(stakeKey))
+	}
+	ret := &Cip36DeregistrationMetadata{
+		Num61286: Cip36Deregistration{
+			StakePublicKey: stakeKey.Public().(ed25519.PublicKey),
+			Nonce:          nonce,
+			VotingPurpose:  votingPurpose,
+		},
+	}
+	hash, err := ret.SigningHash()
+	if err != nil {
+		return nil, err
+	}
+	ret.Num61285.Signature = ed25519.Sign(stakeKey, hash)
+	return ret, nil
+}
+
+func (c *Cip36DeregistrationMetadata) UnmarshalCBOR(cborData []byte) error {
+	var raw map[uint64]cbor.RawMessage
+	if _, err := cbor.Decode(cborData, &raw); err != nil {
+		return err
+	}
+	deregistration, ok := raw[Cip36DeregistrationMetadataLabel]
+	if !ok {
+		return fmt.Errorf("%w %d in CIP-36 metadata", ErrMissingLabel, Cip36DeregistrationMetadataLabel)
+	}
+	witness, ok := raw[Cip36WitnessMetadataLabel]
+	if !ok {
+		return fmt.Errorf("%w %d in CIP-36 metadata", ErrMissingLabel, Cip36WitnessMetadataLabel)
+	}
+	if _, err := cbor.Decode(deregistration, &c.Num61286); err != nil {
+		return err
+	}
+	if _, err := cbor.Decode(witness, &c.Num61285); err != nil {
+		return err
+	}
+	c.rawDeregistration = bytes.Clone(deregistration)
+	c.decodedDeregistration = c.Num61286
+	c.decodedDeregistration.StakePublicKey = bytes.Clone(c.Num61286.StakePublicKey)
+	return nil
+}
+
+func (c *Cip36DeregistrationMetadata) MarshalCBOR() ([]byte, error) {
+	deregistrationCbor, err := c.deregistrationCbor()
+	if err != nil {
+		return nil, err
+	}
+	return cbor.Encode(
+		map[uint64]any{
+			Cip36WitnessMetadataLabel:        &c.Num61285,
+			Cip36DeregistrationMetadataLabel: deregistrationCbor,
+		},
+	)
+}
+
+// SigningHash returns the blake2b-256 hash of the CBOR encoding of the deregistration under its
+// metadata label, which is what the witness signs. The original CBOR is used for a decoded
+// deregistration, since the signature covers the exact bytes that appeared on chain
+func (c *Cip36DeregistrationMetadata) SigningHash() ([]byte, error) {
+	deregistrationCbor, err := c.deregistrationCbor()
+	if err != nil {
+		return nil, err
+	}
+	cborData, err := cbor.Encode(
+		map[uint64]cbor.RawMessage{
+			Cip36DeregistrationMetadataLabel: deregistrationCbor,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	hash := blake2b.Sum256(cborData)
+	return hash[:], nil
+}
+
+// deregistrationCbor returns the original CBOR for the deregistration if it was decoded and
+// hasn't been modified since, and otherwise encodes it
+func (c *Cip36DeregistrationMetadata) deregistrationCbor() (cbor.RawMessage, error) {
+	if c.rawDeregistration != nil && c.Num61286.equal(c.decodedDeregistration) {
+		return c.rawDeregistration, nil
+	}
+	return cbor.Encode(&c.Num61286)
+}
+
+// VerifyWitness checks that the witness contains a valid signature over the deregistration by
+// its stake key
+func (c *Cip36DeregistrationMetadata) VerifyWitness() error {
+	if len(c.Num61286.StakePublicKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid stake public key length: %d", len(c.Num61286.StakePublicKey))
+	}
+	hash, err := c.SigningHash()
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(c.Num61286.StakePublicKey, hash, c.Num61285.Signature) {
+		return errors.New("invalid deregistration witness signature")
+	}
+	return nil
+}
+
+// Validate checks the key and signature lengths and verifies the witness
+func (c *Cip36DeregistrationMetadata) Validate() error {
+	if err := packageValidator().Struct(c); err != nil {
+		return err
+	}
+	return c.VerifyWitness()
+}
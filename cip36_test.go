@@ -0,0 +1,144 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"golang.org/x/crypto/blake2b"
+)
+
+func TestCip36DeregistrationMetadataDecodeEncode(t *testing.T) {
+	testHex := "a219ef65a1015840000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f19ef66a3015820000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f021904d20300"
+	expectedObj := models.Cip36DeregistrationMetadata{
+		Num61286: models.Cip36Deregistration{
+			StakePublicKey: decodeHex("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"),
+			Nonce:          1234,
+			VotingPurpose:  0,
+		},
+		Num61285: models.Cip36Witness{
+			Signature: decodeHex("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"),
+		},
+	}
+	testObj, err := models.DecodeHex[models.Cip36DeregistrationMetadata](testHex)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(testObj.Num61286, expectedObj.Num61286) ||
+		!reflect.DeepEqual(testObj.Num61285, expectedObj.Num61285) {
+		t.Fatalf("CBOR did not decode to expected object\n  got: %#v\n  wanted: %#v", testObj, expectedObj)
+	}
+	cborData, err := cbor.Encode(&testObj)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if hex.EncodeToString(cborData) != testHex {
+		t.Fatalf("object did not encode to expected CBOR\n  got: %x\n  wanted: %s", cborData, testHex)
+	}
+	// The fixture signature is not valid
+	if err := testObj.VerifyWitness(); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+	// Missing witness label
+	_, err = models.DecodeHex[models.Cip36DeregistrationMetadata]("a119ef66a3015820000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f021904d20300")
+	if !errors.Is(err, models.ErrMissingLabel) {
+		t.Fatalf("did not get expected error: %v", err)
+	}
+}
+
+func TestCip36DeregistrationMetadataWitness(t *testing.T) {
+	stakeKey := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	metadata, err := models.NewCip36DeregistrationMetadata(stakeKey, 12345678, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := metadata.Validate(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// The witness survives a round trip through CBOR
+	cborData, err := metadata.MarshalCBOR()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	decoded, err := models.Decode[models.Cip36DeregistrationMetadata](cborData, models.WithStrictMode())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Changing the deregistration invalidates the witness
+	decoded.Num61286.Nonce++
+	if err := decoded.VerifyWitness(); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+	if _, err := models.NewCip36DeregistrationMetadata(stakeKey[:32], 0, 0); err == nil {
+		t.Fatalf("did not get expected error for invalid key")
+	}
+}
+
+// This uses a generated key, since no mainnet deregistration fixture is available yet. A mainnet
+// sample would also cover the exact encoding produced by wallets
+func TestCip36DeregistrationMetadataNonCanonical(t *testing.T) {
+	stakeKey := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	// Deregistration using an indefinite-length map, a non-minimal nonce encoding and keys out of
+	// order, which the witness signs as-is
+	deregistrationCbor := slices.Concat(
+		decodeHex("bf021a000030390300015820"),
+		stakeKey.Public().(ed25519.PublicKey),
+		decodeHex("ff"),
+	)
+	hash := blake2b.Sum256(slices.Concat(decodeHex("a119ef66"), deregistrationCbor))
+	signature := ed25519.Sign(stakeKey, hash[:])
+	cborData := slices.Concat(
+		decodeHex("a219ef65a1015840"),
+		signature,
+		decodeHex("19ef66"),
+		deregistrationCbor,
+	)
+	testObj, err := models.Decode[models.Cip36DeregistrationMetadata](cborData)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if testObj.Num61286.Nonce != 12345 {
+		t.Fatalf("did not get expected nonce: %d", testObj.Num61286.Nonce)
+	}
+	if err := testObj.Validate(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// The original encoding is preserved
+	encoded, err := cbor.Encode(&testObj)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !bytes.Equal(encoded, cborData) {
+		t.Fatalf("object did not encode to original CBOR\n  got: %x\n  wanted: %x", encoded, cborData)
+	}
+	// Modifying the deregistration re-encodes it, which invalidates the witness
+	testObj.Num61286.Nonce++
+	if err := testObj.VerifyWitness(); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+	testObj.Num61286.Nonce--
+	if err := testObj.VerifyWitness(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// DanogoBondDatum represents the datum format used by the Danogo bond contract
+type DanogoBondDatum struct {
+	// BondToken identifies the NFT that represents ownership of the bond
+	BondToken PlutusAssetClass
+	Issuer    PlutusAddress
+	// Principal is the bond face value in lovelace
+	Principal int64
+	// Annual yield in basis points
+	YieldBasisPoints int64
+	// Bond term, in epochs
+	StartEpoch     int64
+	DurationEpochs int64
+}
+
+func (d *DanogoBondDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&d.BondToken,
+			&d.Issuer,
+			d.Principal,
+			d.YieldBasisPoints,
+			d.StartEpoch,
+			d.DurationEpochs,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (d *DanogoBondDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 6)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(
+		fields,
+		&d.BondToken,
+		&d.Issuer,
+		&d.Principal,
+		&d.YieldBasisPoints,
+		&d.StartEpoch,
+		&d.DurationEpochs,
+	)
+}
+
+// MaturityEpoch returns the epoch in which the bond matures
+func (d DanogoBondDatum) MaturityEpoch() int64 {
+	return d.StartEpoch + d.DurationEpochs
+}
+
+// Yield returns the annual yield of the bond as a fraction
+func (d DanogoBondDatum) Yield() *big.Rat {
+	return big.NewRat(d.YieldBasisPoints, 10000)
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"math/big"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestDanogoBondDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799fd8799f581cbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb47426f6e64303432ff" + testPlutusAddressBaseHex + "1b00000002540be4001901c21902081849ff"
+	expectedObj := models.DanogoBondDatum{
+		BondToken: models.PlutusAssetClass{
+			PolicyId:  decodeHex("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+			AssetName: []byte("Bond042"),
+		},
+		Issuer:           testPlutusAddressBase,
+		Principal:        10000000000,
+		YieldBasisPoints: 450,
+		StartEpoch:       520,
+		DurationEpochs:   73,
+	}
+	var testObj models.DanogoBondDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+	if testObj.MaturityEpoch() != 593 {
+		t.Fatalf("did not get expected maturity epoch: got %d", testObj.MaturityEpoch())
+	}
+	if testObj.Yield().Cmp(big.NewRat(9, 200)) != 0 {
+		t.Fatalf("did not get expected yield: got %s", testObj.Yield())
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	_cbor "github.com/fxamacker/cbor/v2"
+)
+
+// DecodeOption configures the behavior of Decode, DecodeHex and the other decoding functions
+// that accept options
+type DecodeOption func(*decodeConfig)
+
+type decodeConfig struct {
+	strict      bool
+	maxDepth    int
+	versionHint int
+	canonical   bool
+}
+
+// WithStrictMode validates the decoded value with its Validate method, for models that have one
+func WithStrictMode() DecodeOption {
+	return func(c *decodeConfig) {
+		c.strict = true
+	}
+}
+
+// WithMaxDepth limits the nesting depth of the CBOR input, counting arrays, maps and tags. The
+// input is checked before it's decoded. The depth must be between 4 and 65535
+func WithMaxDepth(depth int) DecodeOption {
+	return func(c *decodeConfig) {
+		c.maxDepth = depth
+	}
+}
+
+// WithVersionHint sets the format version that the input is expected to have. Decoding fails
+// with ErrInvalidVersion if the decoded model has a different version. Models without a version
+// ignore the hint
+func WithVersionHint(version int) DecodeOption {
+	return func(c *decodeConfig) {
+		c.versionHint = version
+	}
+}
+
+// WithCanonicalEncoding requires the input to be encoded exactly as the model would encode the
+// decoded value, rejecting alternative encodings of the same data
+func WithCanonicalEncoding() DecodeOption {
+	return func(c *decodeConfig) {
+		c.canonical = true
+	}
+}
+
+// versionedModel is implemented by models whose format has a version
+type versionedModel interface {
+	modelVersion() int
+}
+
+func newDecodeConfig(opts []DecodeOption) decodeConfig {
+	var ret decodeConfig
+	for _, opt := range opts {
+		opt(&ret)
+	}
+	return ret
+}
+
+// checkInput applies the options that apply to the input before it's decoded
+func (c decodeConfig) checkInput(cborData []byte) error {
+	if c.maxDepth == 0 {
+		return nil
+	}
+	decMode, err := _cbor.DecOptions{MaxNestedLevels: c.maxDepth}.DecMode()
+	if err != nil {
+		return err
+	}
+	return decMode.Wellformed(cborData)
+}
+
+// checkResult applies the options that apply to the decoded value
+func (c decodeConfig) checkResult(cborData []byte, val any) error {
+	if c.versionHint != 0 {
+		if versioned, ok := val.(versionedModel); ok && versioned.modelVersion() != c.versionHint {
+			return fmt.Errorf("%w: %d, expected %d", ErrInvalidVersion, versioned.modelVersion(), c.versionHint)
+		}
+	}
+	if c.canonical {
+		encoded, err := cbor.Encode(val)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(encoded, cborData) {
+			return fmt.Errorf("input is not canonically encoded, expected: %x", encoded)
+		}
+	}
+	if c.strict {
+		if validator, ok := val.(interface{ Validate() error }); ok {
+			return validator.Validate()
+		}
+	}
+	return nil
+}
+
+// Decode decodes CBOR data into a new value of the provided model type, using the model's CBOR
+// unmarshaler. An error is returned if there is data left over after the value
+func Decode[T any](cborData []byte, opts ...DecodeOption) (T, error) {
+	var ret T
+	cfg := newDecodeConfig(opts)
+	if err := cfg.checkInput(cborData); err != nil {
+		return ret, err
+	}
+	bytesRead, err := cbor.Decode(cborData, &ret)
+	if err != nil {
+		return ret, err
+	}
+	if bytesRead != len(cborData) {
+		return ret, fmt.Errorf("unexpected trailing data after CBOR value: %d bytes", len(cborData)-bytesRead)
+	}
+	if err := cfg.checkResult(cborData, &ret); err != nil {
+		return ret, err
+	}
+	return ret, nil
+}
+
+// DecodeHex decodes hex-encoded CBOR data into a new value of the provided model type
+func DecodeHex[T any](cborHex string, opts ...DecodeOption) (T, error) {
+	cborData, err := hex.DecodeString(cborHex)
+	if err != nil {
+		var ret T
+		return ret, fmt.Errorf("%w: %w", ErrNotHex, err)
+	}
+	return Decode[T](cborData, opts...)
+}
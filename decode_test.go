@@ -0,0 +1,97 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestDecode(t *testing.T) {
+	testHex := "d8799f197a081b00000162b1b74200ff"
+	expectedObj := models.TunaHardForkLockState{
+		BlockHeight:       31240,
+		CurrentLockedTuna: 1523400000000,
+	}
+	testObj, err := models.Decode[models.TunaHardForkLockState](decodeHex(testHex))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(testObj, expectedObj) {
+		t.Fatalf("did not get expected object: %#v", testObj)
+	}
+	testObj, err = models.DecodeHex[models.TunaHardForkLockState](testHex)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(testObj, expectedObj) {
+		t.Fatalf("did not get expected object: %#v", testObj)
+	}
+	// Types with custom unmarshalers are supported
+	domain, err := models.DecodeHex[models.CardanoDnsDomain](cardanoDnsTestDefs[0].cborHex)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(domain, cardanoDnsTestDefs[0].expectedObj) {
+		t.Fatalf("did not get expected object: %s", domain.String())
+	}
+	// Invalid input
+	if _, err := models.DecodeHex[models.TunaHardForkLockState]("zz"); err == nil {
+		t.Fatalf("did not get expected error for invalid hex")
+	}
+	if _, err := models.DecodeHex[models.TunaHardForkLockState](testHex + "00"); err == nil {
+		t.Fatalf("did not get expected error for trailing data")
+	}
+	if _, err := models.DecodeHex[models.CardanoDnsDomain](testHex); err == nil {
+		t.Fatalf("did not get expected error for wrong type")
+	}
+}
+
+func TestDecodeOptions(t *testing.T) {
+	testHex := "d8799f197a081b00000162b1b74200ff"
+	// The same datum using a definite-length list
+	definiteHex := "d87982197a081b00000162b1b74200"
+	// A lock state with a negative block height
+	invalidHex := "d8799f2000ff"
+	// Nested lists 6 levels deep
+	nestedHex := "818181818100"
+	if _, err := models.DecodeHex[models.TunaHardForkLockState](testHex, models.WithCanonicalEncoding()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := models.DecodeHex[models.TunaHardForkLockState](definiteHex); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := models.DecodeHex[models.TunaHardForkLockState](definiteHex, models.WithCanonicalEncoding()); err == nil {
+		t.Fatalf("did not get expected error for non-canonical encoding")
+	}
+	if _, err := models.DecodeHex[models.TunaHardForkLockState](invalidHex); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := models.DecodeHex[models.TunaHardForkLockState](invalidHex, models.WithStrictMode()); err == nil {
+		t.Fatalf("did not get expected error in strict mode")
+	}
+	if _, err := models.DecodeHex[models.TunaHardForkLockState](testHex, models.WithMaxDepth(4)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := models.DecodeHex[any](nestedHex, models.WithMaxDepth(4)); err == nil {
+		t.Fatalf("did not get expected error for nesting depth")
+	}
+	// Models without a version ignore the version hint
+	if _, err := models.DecodeHex[models.TunaHardForkLockState](testHex, models.WithVersionHint(2)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// DjedReserveDatum represents the state datum held on the Djed reserve UTxO. The ADA reserve
+// itself is the lovelace held in the UTxO value
+type DjedReserveDatum struct {
+	// Circulating supply of the stablecoin (DJED), in its smallest unit
+	StablecoinCirculating int64
+	// Circulating supply of the reservecoin (SHEN), in its smallest unit
+	ReservecoinCirculating int64
+	// OracleNft identifies the oracle UTxO that provides the exchange rate
+	OracleNft PlutusAssetClass
+}
+
+func (d *DjedReserveDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			d.StablecoinCirculating,
+			d.ReservecoinCirculating,
+			&d.OracleNft,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (d *DjedReserveDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 3)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(
+		fields,
+		&d.StablecoinCirculating,
+		&d.ReservecoinCirculating,
+		&d.OracleNft,
+	)
+}
+
+// ReserveRatio returns the ratio of the ADA reserve (valued in USD) to the stablecoin liabilities,
+// given the lovelace held in the reserve UTxO and the current oracle datum. It returns nil when
+// there is no stablecoin in circulation
+func (d DjedReserveDatum) ReserveRatio(reserveLovelace int64, oracle DjedOracleDatum) *big.Rat {
+	if d.StablecoinCirculating == 0 {
+		return nil
+	}
+	// Both ADA and DJED use 6 decimal places, so the smallest units can be compared directly
+	ret := new(big.Rat).Mul(big.NewRat(reserveLovelace, 1), oracle.Price())
+	return ret.Quo(ret, big.NewRat(d.StablecoinCirculating, 1))
+}
+
+// DjedOracleDatum represents the datum published by the Djed ADA/USD oracle
+type DjedOracleDatum struct {
+	// The exchange rate is expressed as USD per ADA
+	RateNumerator   int64
+	RateDenominator int64
+	// Validity range of the rate, in POSIX milliseconds
+	ValidFrom int64
+	ValidTo   int64
+}
+
+func (d *DjedOracleDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			cbor.NewConstructor(
+				0,
+				cbor.IndefLengthList{
+					d.RateNumerator,
+					d.RateDenominator,
+				},
+			),
+			cbor.NewConstructor(
+				0,
+				cbor.IndefLengthList{
+					d.ValidFrom,
+					d.ValidTo,
+				},
+			),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (d *DjedOracleDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	rateConstr, rateFields, err := decodePlutusConstr(fields[0], 2)
+	if err != nil {
+		return err
+	}
+	if rateConstr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, rateConstr)
+	}
+	if err := decodePlutusFields(rateFields, &d.RateNumerator, &d.RateDenominator); err != nil {
+		return err
+	}
+	if d.RateDenominator == 0 {
+		return fmt.Errorf("invalid exchange rate denominator: %d", d.RateDenominator)
+	}
+	validityConstr, validityFields, err := decodePlutusConstr(fields[1], 2)
+	if err != nil {
+		return err
+	}
+	if validityConstr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, validityConstr)
+	}
+	return decodePlutusFields(validityFields, &d.ValidFrom, &d.ValidTo)
+}
+
+func (d DjedOracleDatum) Pair() PriceFeedPair {
+	return PriceFeedPair{
+		Base:  "ADA",
+		Quote: "USD",
+	}
+}
+
+func (d DjedOracleDatum) Price() *big.Rat {
+	return big.NewRat(d.RateNumerator, d.RateDenominator)
+}
+
+func (d DjedOracleDatum) Timestamp() time.Time {
+	return time.UnixMilli(d.ValidFrom)
+}
+
+func (d DjedOracleDatum) Expiry() time.Time {
+	return time.UnixMilli(d.ValidTo)
+}
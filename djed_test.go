@@ -0,0 +1,72 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"math/big"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestDjedReserveDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799f1b00001c685d52e4cb1b000059d39e7f3b34d8799f581c8db269c3ec630e06ae29f74bc39edd1f87c819f1056206e879a1cd614a446a65644f7261636c65ffff"
+	expectedObj := models.DjedReserveDatum{
+		StablecoinCirculating:  31234567890123,
+		ReservecoinCirculating: 98765432109876,
+		OracleNft: models.PlutusAssetClass{
+			PolicyId:  decodeHex("8db269c3ec630e06ae29f74bc39edd1f87c819f1056206e879a1cd61"),
+			AssetName: []byte("DjedOracle"),
+		},
+	}
+	var testObj models.DjedReserveDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+}
+
+func TestDjedOracleDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799fd8799f1a000499f51a000f4240ffd8799f1b000001941f297c001b000001941f606a80ffff"
+	expectedObj := models.DjedOracleDatum{
+		RateNumerator:   301557,
+		RateDenominator: 1000000,
+		ValidFrom:       1735689600000,
+		ValidTo:         1735693200000,
+	}
+	var testObj models.DjedOracleDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+	var feed models.PriceFeed = testObj
+	if feed.Pair().String() != "ADA/USD" {
+		t.Fatalf("did not get expected pair: got %s", feed.Pair())
+	}
+	if feed.Price().Cmp(big.NewRat(301557, 1000000)) != 0 {
+		t.Fatalf("did not get expected price: got %s", feed.Price())
+	}
+}
+
+func TestDjedReserveRatio(t *testing.T) {
+	oracle := models.DjedOracleDatum{
+		RateNumerator:   301557,
+		RateDenominator: 1000000,
+	}
+	datum := models.DjedReserveDatum{
+		StablecoinCirculating: 1000000,
+	}
+	ratio := datum.ReserveRatio(10000000, oracle)
+	if ratio == nil || ratio.Cmp(big.NewRat(301557, 100000)) != 0 {
+		t.Fatalf("did not get expected reserve ratio: got %v", ratio)
+	}
+	if (models.DjedReserveDatum{}).ReserveRatio(10000000, oracle) != nil {
+		t.Fatalf("expected nil reserve ratio with no stablecoin in circulation")
+	}
+}
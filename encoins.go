@@ -0,0 +1,148 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// EncoinsPolarity identifies whether an ENCOINS input mints or burns a commitment token
+type EncoinsPolarity uint
+
+const (
+	EncoinsPolarityMint EncoinsPolarity = 0
+	EncoinsPolarityBurn EncoinsPolarity = 1
+)
+
+// EncoinsInput represents a single commitment token minted or burned by an ENCOINS transaction
+type EncoinsInput struct {
+	// Commitment is the token name of the commitment token
+	Commitment []byte
+	Polarity   EncoinsPolarity
+}
+
+func (e *EncoinsInput) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			e.Commitment,
+			cbor.NewConstructor(uint(e.Polarity), []any{}),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (e *EncoinsInput) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpPolarity cbor.RawMessage
+	if err := decodePlutusFields(fields, &e.Commitment, &tmpPolarity); err != nil {
+		return err
+	}
+	polarityConstr, _, err := decodePlutusConstr(tmpPolarity, 0)
+	if err != nil {
+		return err
+	}
+	switch EncoinsPolarity(polarityConstr) {
+	case EncoinsPolarityMint, EncoinsPolarityBurn:
+		e.Polarity = EncoinsPolarity(polarityConstr)
+	default:
+		return fmt.Errorf("%w: polarity %d", ErrUnexpectedConstructor, polarityConstr)
+	}
+	return nil
+}
+
+// EncoinsRedeemer represents the redeemer used by the ENCOINS minting policy. The zero-knowledge
+// proof itself is verified off-chain, so only its hash and the relay signature are included
+type EncoinsRedeemer struct {
+	LedgerAddress PlutusAddress
+	ChangeAddress PlutusAddress
+	// Value is the amount of ADA deposited into (positive) or withdrawn from (negative) the ledger
+	Value     int64
+	Inputs    []EncoinsInput
+	ProofHash []byte
+	Signature []byte
+}
+
+func (e *EncoinsRedeemer) MarshalCBOR() ([]byte, error) {
+	var inputs any = []any{}
+	if len(e.Inputs) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(e.Inputs))
+		for idx := range e.Inputs {
+			tmpList = append(tmpList, &e.Inputs[idx])
+		}
+		inputs = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			cbor.NewConstructor(
+				0,
+				cbor.IndefLengthList{
+					&e.LedgerAddress,
+					&e.ChangeAddress,
+				},
+			),
+			cbor.NewConstructor(
+				0,
+				cbor.IndefLengthList{
+					e.Value,
+					inputs,
+				},
+			),
+			e.ProofHash,
+			e.Signature,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (e *EncoinsRedeemer) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 4)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	addrConstr, addrFields, err := decodePlutusConstr(fields[0], 2)
+	if err != nil {
+		return err
+	}
+	if addrConstr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, addrConstr)
+	}
+	if err := decodePlutusFields(addrFields, &e.LedgerAddress, &e.ChangeAddress); err != nil {
+		return err
+	}
+	inputConstr, inputFields, err := decodePlutusConstr(fields[1], 2)
+	if err != nil {
+		return err
+	}
+	if inputConstr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, inputConstr)
+	}
+	if err := decodePlutusFields(inputFields, &e.Value, &e.Inputs); err != nil {
+		return err
+	}
+	return decodePlutusFields(fields[2:], &e.ProofHash, &e.Signature)
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestEncoinsRedeemerDecodeEncode(t *testing.T) {
+	cborHex := "d8799fd8799fd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ffd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffffd8799f249fd8799f58201111111111111111111111111111111111111111111111111111111111111111d87980ffd8799f58202222222222222222222222222222222222222222222222222222222222222222d87a80ffffff58203333333333333333333333333333333333333333333333333333333333333333584044444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444444ff"
+	expectedObj := models.EncoinsRedeemer{
+		LedgerAddress: testPlutusAddressScript,
+		ChangeAddress: testPlutusAddressBase,
+		Value:         -5,
+		Inputs: []models.EncoinsInput{
+			{
+				Commitment: bytes.Repeat([]byte{0x11}, 32),
+				Polarity:   models.EncoinsPolarityMint,
+			},
+			{
+				Commitment: bytes.Repeat([]byte{0x22}, 32),
+				Polarity:   models.EncoinsPolarityBurn,
+			},
+		},
+		ProofHash: bytes.Repeat([]byte{0x33}, 32),
+		Signature: bytes.Repeat([]byte{0x44}, 64),
+	}
+	var testObj models.EncoinsRedeemer
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+}
+
+func TestEncoinsInputInvalidPolarity(t *testing.T) {
+	inputPrefixHex := "d8799f58201111111111111111111111111111111111111111111111111111111111111111"
+	_, err := models.DecodeHex[models.EncoinsInput](inputPrefixHex + "d87b80ff")
+	if !errors.Is(err, models.ErrUnexpectedConstructor) {
+		t.Fatalf("did not get expected error: %v", err)
+	}
+	if _, err := models.DecodeHex[models.EncoinsInput](inputPrefixHex + "d86580ff"); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"errors"
+)
+
+// Sentinel errors wrapped by the decoders and validators, for use with errors.Is
+var (
+	// ErrUnexpectedConstructor is returned when a Plutus datum uses a constructor index that
+	// the model doesn't support
+	ErrUnexpectedConstructor = errors.New("unexpected constructor index")
+	// ErrUnexpectedFieldCount is returned when a Plutus constructor has the wrong number of fields
+	ErrUnexpectedFieldCount = errors.New("unexpected number of fields")
+	// ErrMissingLabel is returned when metadata is missing a label required by the model
+	ErrMissingLabel = errors.New("missing metadata label")
+	// ErrInvalidVersion is returned when a datum or metadata has an unsupported version
+	ErrInvalidVersion = errors.New("invalid version")
+	// ErrNotHex is returned when a value that should be hex-encoded is not valid hex
+	ErrNotHex = errors.New("invalid hex")
+)
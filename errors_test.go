@@ -0,0 +1,79 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"errors"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	testDefs := []struct {
+		name    string
+		decode  func() error
+		wantErr error
+	}{
+		{
+			name: "unexpected constructor",
+			decode: func() error {
+				_, err := models.DecodeHex[models.NebulaRoyaltyInfo]("d87a9f8001d87980ff")
+				return err
+			},
+			wantErr: models.ErrUnexpectedConstructor,
+		},
+		{
+			name: "unexpected field count",
+			decode: func() error {
+				_, err := models.DecodeHex[models.NebulaRoyaltyInfo]("d8799f8001ff")
+				return err
+			},
+			wantErr: models.ErrUnexpectedFieldCount,
+		},
+		{
+			name: "invalid version",
+			decode: func() error {
+				_, err := models.DecodeHex[models.NebulaRoyaltyInfo]("d8799f8002d87980ff")
+				return err
+			},
+			wantErr: models.ErrInvalidVersion,
+		},
+		{
+			name: "missing label",
+			decode: func() error {
+				_, err := models.DecodeHex[models.Cip27Metadata]("a1186401")
+				return err
+			},
+			wantErr: models.ErrMissingLabel,
+		},
+		{
+			name: "not hex",
+			decode: func() error {
+				_, err := models.DecodeHex[models.NebulaRoyaltyInfo]("zz")
+				return err
+			},
+			wantErr: models.ErrNotHex,
+		},
+	}
+	for _, testDef := range testDefs {
+		t.Run(testDef.name, func(t *testing.T) {
+			err := testDef.decode()
+			if !errors.Is(err, testDef.wantErr) {
+				t.Fatalf("expected %v, got: %v", testDef.wantErr, err)
+			}
+		})
+	}
+}
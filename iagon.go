@@ -0,0 +1,108 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// IagonDealType identifies the kind of resource provided under an Iagon deal
+type IagonDealType uint
+
+const (
+	IagonDealTypeStorage IagonDealType = 0
+	IagonDealTypeCompute IagonDealType = 1
+)
+
+// IagonDealDatum represents the datum format used by Iagon storage and compute deals
+type IagonDealDatum struct {
+	Provider PlutusCredential
+	Client   PlutusCredential
+	Type     IagonDealType
+	// Capacity is in bytes for storage deals and compute units for compute deals
+	Capacity int64
+	// Price per epoch in the smallest unit of IAG
+	PricePerEpoch int64
+	// Deal term, in epochs. The deal ends at the start of EndEpoch
+	StartEpoch int64
+	EndEpoch   int64
+}
+
+func (i *IagonDealDatum) MarshalCBOR() ([]byte, error) {
+	switch i.Type {
+	case IagonDealTypeStorage, IagonDealTypeCompute:
+	default:
+		return nil, fmt.Errorf("unknown deal type: %d", i.Type)
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&i.Provider,
+			&i.Client,
+			cbor.NewConstructor(uint(i.Type), []any{}),
+			i.Capacity,
+			i.PricePerEpoch,
+			i.StartEpoch,
+			i.EndEpoch,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (i *IagonDealDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 7)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpType cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&i.Provider,
+		&i.Client,
+		&tmpType,
+		&i.Capacity,
+		&i.PricePerEpoch,
+		&i.StartEpoch,
+		&i.EndEpoch,
+	); err != nil {
+		return err
+	}
+	typeConstr, _, err := decodePlutusConstr(tmpType, 0)
+	if err != nil {
+		return err
+	}
+	switch IagonDealType(typeConstr) {
+	case IagonDealTypeStorage, IagonDealTypeCompute:
+		i.Type = IagonDealType(typeConstr)
+	default:
+		return fmt.Errorf("%w: deal type %d", ErrUnexpectedConstructor, typeConstr)
+	}
+	return nil
+}
+
+// DurationEpochs returns the length of the deal term in epochs
+func (i IagonDealDatum) DurationEpochs() int64 {
+	return i.EndEpoch - i.StartEpoch
+}
+
+// TotalPrice returns the total price of the deal over its full term
+func (i IagonDealDatum) TotalPrice() int64 {
+	return i.PricePerEpoch * i.DurationEpochs()
+}
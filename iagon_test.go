@@ -0,0 +1,86 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestIagonDealDatumDecodeEncode(t *testing.T) {
+	provider := models.PlutusCredential{
+		Type: models.PlutusCredentialTypeScript,
+		Hash: decodeHex("a65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3b"),
+	}
+	client := models.PlutusCredential{
+		Type: models.PlutusCredentialTypePubKey,
+		Hash: decodeHex("1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5"),
+	}
+	testDefs := []struct {
+		cborHex            string
+		expectedObj        models.IagonDealDatum
+		expectedTotalPrice int64
+	}{
+		{
+			// 1 TiB storage deal
+			cborHex: "d8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd879801b00000100000000001a9502f900190208190251ff",
+			expectedObj: models.IagonDealDatum{
+				Provider:      provider,
+				Client:        client,
+				Type:          models.IagonDealTypeStorage,
+				Capacity:      1099511627776,
+				PricePerEpoch: 2500000000,
+				StartEpoch:    520,
+				EndEpoch:      593,
+			},
+			expectedTotalPrice: 182500000000,
+		},
+		{
+			// Compute deal
+			cborHex: "d8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd87a8018401a05f5e10019020819020eff",
+			expectedObj: models.IagonDealDatum{
+				Provider:      provider,
+				Client:        client,
+				Type:          models.IagonDealTypeCompute,
+				Capacity:      64,
+				PricePerEpoch: 100000000,
+				StartEpoch:    520,
+				EndEpoch:      526,
+			},
+			expectedTotalPrice: 600000000,
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.IagonDealDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+		if testObj.TotalPrice() != testDef.expectedTotalPrice {
+			t.Fatalf("did not get expected total price: got %d", testObj.TotalPrice())
+		}
+	}
+}
+
+func TestIagonDealDatumInvalidType(t *testing.T) {
+	testHexTemplate := "d8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ff%s1b00000100000000001a9502f900190208190251ff"
+	_, err := models.DecodeHex[models.IagonDealDatum](fmt.Sprintf(testHexTemplate, "d87b80"))
+	if !errors.Is(err, models.ErrUnexpectedConstructor) {
+		t.Fatalf("did not get expected error: %v", err)
+	}
+	if _, err := models.DecodeHex[models.IagonDealDatum](fmt.Sprintf(testHexTemplate, "d86580")); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// IndigoCdpFeesType identifies how fees are tracked for an Indigo CDP
+type IndigoCdpFeesType uint
+
+const (
+	IndigoCdpFeesActive IndigoCdpFeesType = 0
+	IndigoCdpFeesFrozen IndigoCdpFeesType = 1
+)
+
+// IndigoCdpFees represents the fee tracking state of an Indigo CDP. Only the fields relevant
+// to the type are used
+type IndigoCdpFees struct {
+	Type IndigoCdpFeesType
+	// Used by active CDPs
+	LastSettled             int64
+	UnitaryInterestSnapshot int64
+	// Used by frozen CDPs
+	LovelacesTreasury    int64
+	LovelacesIndyStakers int64
+}
+
+func (i *IndigoCdpFees) MarshalCBOR() ([]byte, error) {
+	var fields cbor.IndefLengthList
+	switch i.Type {
+	case IndigoCdpFeesActive:
+		fields = cbor.IndefLengthList{i.LastSettled, i.UnitaryInterestSnapshot}
+	case IndigoCdpFeesFrozen:
+		fields = cbor.IndefLengthList{i.LovelacesTreasury, i.LovelacesIndyStakers}
+	default:
+		return nil, fmt.Errorf("unknown CDP fees type: %d", i.Type)
+	}
+	tmp := cbor.NewConstructor(uint(i.Type), fields)
+	return cbor.Encode(&tmp)
+}
+
+func (i *IndigoCdpFees) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	*i = IndigoCdpFees{
+		Type: IndigoCdpFeesType(constr),
+	}
+	switch i.Type {
+	case IndigoCdpFeesActive:
+		return decodePlutusFields(fields, &i.LastSettled, &i.UnitaryInterestSnapshot)
+	case IndigoCdpFeesFrozen:
+		return decodePlutusFields(fields, &i.LovelacesTreasury, &i.LovelacesIndyStakers)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// IndigoCdpDatum represents the datum format used by the Indigo CDP contract
+type IndigoCdpDatum struct {
+	// Owner is the public key hash of the CDP owner, or nil for a frozen CDP
+	Owner        []byte
+	IAsset       []byte
+	MintedAmount int64
+	Fees         IndigoCdpFees
+}
+
+func (i *IndigoCdpDatum) MarshalCBOR() ([]byte, error) {
+	var owner *[]byte
+	if i.Owner != nil {
+		owner = &i.Owner
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			cbor.NewConstructor(
+				0,
+				cbor.IndefLengthList{
+					encodePlutusMaybe(owner),
+					i.IAsset,
+					i.MintedAmount,
+					&i.Fees,
+				},
+			),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (i *IndigoCdpDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 1)
+	if err != nil {
+		return err
+	}
+	// Other constructors are used for iAsset datums, which share the CDP validator
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	cdpConstr, cdpFields, err := decodePlutusConstr(fields[0], 4)
+	if err != nil {
+		return err
+	}
+	if cdpConstr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, cdpConstr)
+	}
+	var tmpOwner cbor.RawMessage
+	if err := decodePlutusFields(
+		cdpFields,
+		&tmpOwner,
+		&i.IAsset,
+		&i.MintedAmount,
+		&i.Fees,
+	); err != nil {
+		return err
+	}
+	owner, err := decodePlutusMaybe[[]byte](tmpOwner)
+	if err != nil {
+		return err
+	}
+	i.Owner = nil
+	if owner != nil {
+		i.Owner = *owner
+	}
+	return nil
+}
+
+// CollateralRatio returns the ratio of the CDP collateral to the value of its minted iAsset, given
+// the lovelace collateral held in the CDP UTxO and the iAsset price in lovelace. It returns nil
+// when the CDP has no minted iAsset
+func (i IndigoCdpDatum) CollateralRatio(collateralLovelace int64, iAssetPrice *big.Rat) *big.Rat {
+	if i.MintedAmount == 0 || iAssetPrice.Sign() == 0 {
+		return nil
+	}
+	mintedValue := new(big.Rat).Mul(big.NewRat(i.MintedAmount, 1), iAssetPrice)
+	return new(big.Rat).Quo(big.NewRat(collateralLovelace, 1), mintedValue)
+}
+
+// IndigoStabilityPoolSnapshot represents the running product/sum snapshot used by the Indigo
+// stability pool to track deposits and liquidation rewards. Values use 18 decimal places
+type IndigoStabilityPoolSnapshot struct {
+	Product big.Int
+	Deposit big.Int
+	Sum     big.Int
+	Epoch   int64
+	Scale   int64
+}
+
+func (i *IndigoStabilityPoolSnapshot) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			cbor.NewConstructor(0, cbor.IndefLengthList{&i.Product}),
+			cbor.NewConstructor(0, cbor.IndefLengthList{&i.Deposit}),
+			cbor.NewConstructor(0, cbor.IndefLengthList{&i.Sum}),
+			i.Epoch,
+			i.Scale,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (i *IndigoStabilityPoolSnapshot) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 5)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	// The product, deposit and sum are each wrapped in a single field constructor
+	for idx, dest := range []*big.Int{&i.Product, &i.Deposit, &i.Sum} {
+		valConstr, valFields, err := decodePlutusConstr(fields[idx], 1)
+		if err != nil {
+			return err
+		}
+		if valConstr != 0 {
+			return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, valConstr)
+		}
+		if _, err := cbor.Decode(valFields[0], dest); err != nil {
+			return err
+		}
+	}
+	return decodePlutusFields(fields[3:], &i.Epoch, &i.Scale)
+}
+
+// IndigoStabilityPoolDatum represents the datum format used by the Indigo stability pool contract
+type IndigoStabilityPoolDatum struct {
+	IAsset   []byte
+	Snapshot IndigoStabilityPoolSnapshot
+	// Historical sums keyed by epoch and scale, which are needed to settle old accounts
+	EpochToScaleToSum cbor.RawMessage
+}
+
+func (i *IndigoStabilityPoolDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			cbor.NewConstructor(
+				0,
+				cbor.IndefLengthList{
+					i.IAsset,
+					&i.Snapshot,
+					i.EpochToScaleToSum,
+				},
+			),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (i *IndigoStabilityPoolDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 1)
+	if err != nil {
+		return err
+	}
+	// Other constructors are used for account and snapshot datums, which share the validator
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	poolConstr, poolFields, err := decodePlutusConstr(fields[0], 3)
+	if err != nil {
+		return err
+	}
+	if poolConstr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, poolConstr)
+	}
+	return decodePlutusFields(
+		poolFields,
+		&i.IAsset,
+		&i.Snapshot,
+		&i.EpochToScaleToSum,
+	)
+}
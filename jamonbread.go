@@ -0,0 +1,185 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// JamOnBreadRoyalty specifies the royalty paid to the creator when a listing is sold
+type JamOnBreadRoyalty struct {
+	Address PlutusAddress
+	// Royalty rate in basis points of the sale price
+	RateBasisPoints int64
+}
+
+func (j *JamOnBreadRoyalty) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&j.Address,
+			j.RateBasisPoints,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (j *JamOnBreadRoyalty) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &j.Address, &j.RateBasisPoints)
+}
+
+// JamOnBreadListingDatum represents the datum format used by Jam On Bread marketplace listings
+type JamOnBreadListingDatum struct {
+	Seller PlutusAddress
+	// Price is the sale price in lovelace
+	Price int64
+	// Royalty is nil when the collection doesn't specify a royalty
+	Royalty *JamOnBreadRoyalty
+	// Fee paid to the affiliate that facilitated the sale, in basis points of the sale price
+	AffiliateFeeBasisPoints int64
+}
+
+func (j *JamOnBreadListingDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&j.Seller,
+			j.Price,
+			encodePlutusMaybe(j.Royalty),
+			j.AffiliateFeeBasisPoints,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (j *JamOnBreadListingDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 4)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpRoyalty cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&j.Seller,
+		&j.Price,
+		&tmpRoyalty,
+		&j.AffiliateFeeBasisPoints,
+	); err != nil {
+		return err
+	}
+	royalty, err := decodePlutusMaybe[JamOnBreadRoyalty](tmpRoyalty)
+	if err != nil {
+		return err
+	}
+	j.Royalty = royalty
+	return nil
+}
+
+// RoyaltyAmount returns the lovelace paid to the creator when the listing is sold
+func (j JamOnBreadListingDatum) RoyaltyAmount() int64 {
+	if j.Royalty == nil {
+		return 0
+	}
+	return j.Price * j.Royalty.RateBasisPoints / 10000
+}
+
+// AffiliateFeeAmount returns the lovelace paid to the affiliate when the listing is sold
+func (j JamOnBreadListingDatum) AffiliateFeeAmount() int64 {
+	return j.Price * j.AffiliateFeeBasisPoints / 10000
+}
+
+// JamOnBreadFeeSplit represents a single recipient of the Jam On Bread treasury fees
+type JamOnBreadFeeSplit struct {
+	Address PlutusAddress
+	Shares  int64
+}
+
+func (j *JamOnBreadFeeSplit) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&j.Address,
+			j.Shares,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (j *JamOnBreadFeeSplit) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &j.Address, &j.Shares)
+}
+
+// JamOnBreadTreasuryDatum represents the datum format used by the Jam On Bread treasury, which
+// specifies how collected fees are split between recipients
+type JamOnBreadTreasuryDatum struct {
+	FeeSplits []JamOnBreadFeeSplit
+}
+
+func (j *JamOnBreadTreasuryDatum) MarshalCBOR() ([]byte, error) {
+	var feeSplits any = []any{}
+	if len(j.FeeSplits) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(j.FeeSplits))
+		for idx := range j.FeeSplits {
+			tmpList = append(tmpList, &j.FeeSplits[idx])
+		}
+		feeSplits = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			feeSplits,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (j *JamOnBreadTreasuryDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 1)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &j.FeeSplits)
+}
+
+// TotalShares returns the sum of the shares of all fee split recipients
+func (j JamOnBreadTreasuryDatum) TotalShares() int64 {
+	var ret int64
+	for _, feeSplit := range j.FeeSplits {
+		ret += feeSplit.Shares
+	}
+	return ret
+}
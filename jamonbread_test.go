@@ -0,0 +1,84 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestJamOnBreadListingDatumDecodeEncode(t *testing.T) {
+	testDefs := []struct {
+		cborHex              string
+		expectedObj          models.JamOnBreadListingDatum
+		expectedRoyalty      int64
+		expectedAffiliateFee int64
+	}{
+		{
+			cborHex: "d8799f" + testPlutusAddressBaseHex + "1a08f0d180d8799fd8799fd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ff1901f4ffff1864ff",
+			expectedObj: models.JamOnBreadListingDatum{
+				Seller: testPlutusAddressBase,
+				Price:  150000000,
+				Royalty: &models.JamOnBreadRoyalty{
+					Address:         testPlutusAddressScript,
+					RateBasisPoints: 500,
+				},
+				AffiliateFeeBasisPoints: 100,
+			},
+			expectedRoyalty:      7500000,
+			expectedAffiliateFee: 1500000,
+		},
+		{
+			// No royalty or affiliate
+			cborHex: "d8799f" + testPlutusAddressBaseHex + "1a08f0d180d87a8000ff",
+			expectedObj: models.JamOnBreadListingDatum{
+				Seller: testPlutusAddressBase,
+				Price:  150000000,
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.JamOnBreadListingDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+		if testObj.RoyaltyAmount() != testDef.expectedRoyalty {
+			t.Fatalf("did not get expected royalty amount: got %d", testObj.RoyaltyAmount())
+		}
+		if testObj.AffiliateFeeAmount() != testDef.expectedAffiliateFee {
+			t.Fatalf("did not get expected affiliate fee amount: got %d", testObj.AffiliateFeeAmount())
+		}
+	}
+}
+
+func TestJamOnBreadTreasuryDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799f9fd8799f" + testPlutusAddressBaseHex + "03ffd8799fd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ff01ffffff"
+	expectedObj := models.JamOnBreadTreasuryDatum{
+		FeeSplits: []models.JamOnBreadFeeSplit{
+			{
+				Address: testPlutusAddressBase,
+				Shares:  3,
+			},
+			{
+				Address: testPlutusAddressScript,
+				Shares:  1,
+			},
+		},
+	}
+	var testObj models.JamOnBreadTreasuryDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+	if testObj.TotalShares() != 4 {
+		t.Fatalf("did not get expected total shares: got %d", testObj.TotalShares())
+	}
+}
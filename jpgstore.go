@@ -0,0 +1,195 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// JpgStorePayout represents a lovelace payout required by a jpg.store V3 ask
+type JpgStorePayout struct {
+	Address        PlutusAddress
+	AmountLovelace int64
+}
+
+func (j *JpgStorePayout) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&j.Address,
+			j.AmountLovelace,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (j *JpgStorePayout) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &j.Address, &j.AmountLovelace)
+}
+
+// JpgStoreValuePayout represents a multi-asset payout, as used by jpg.store V2 asks and by bids
+// and collection offers
+type JpgStoreValuePayout struct {
+	Address PlutusAddress
+	Value   PlutusValue
+}
+
+func (j *JpgStoreValuePayout) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&j.Address,
+			&j.Value,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (j *JpgStoreValuePayout) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &j.Address, &j.Value)
+}
+
+// JpgStoreV3AskDatum represents the datum format used by jpg.store V3 listings
+type JpgStoreV3AskDatum struct {
+	Payouts []JpgStorePayout
+	// Owner is the public key hash that is allowed to cancel or update the listing
+	Owner []byte
+}
+
+func (j *JpgStoreV3AskDatum) MarshalCBOR() ([]byte, error) {
+	var payouts any = []any{}
+	if len(j.Payouts) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(j.Payouts))
+		for idx := range j.Payouts {
+			tmpList = append(tmpList, &j.Payouts[idx])
+		}
+		payouts = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			payouts,
+			j.Owner,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (j *JpgStoreV3AskDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &j.Payouts, &j.Owner)
+}
+
+// Price returns the total lovelace paid out by the listing
+func (j JpgStoreV3AskDatum) Price() int64 {
+	var ret int64
+	for _, payout := range j.Payouts {
+		ret += payout.AmountLovelace
+	}
+	return ret
+}
+
+// JpgStoreV2AskDatum represents the datum format used by jpg.store V2 listings
+type JpgStoreV2AskDatum struct {
+	Payouts []JpgStoreValuePayout
+	// Owner is the public key hash that is allowed to cancel or update the listing
+	Owner []byte
+}
+
+func (j *JpgStoreV2AskDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			encodeJpgStoreValuePayouts(j.Payouts),
+			j.Owner,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (j *JpgStoreV2AskDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &j.Payouts, &j.Owner)
+}
+
+// JpgStoreOfferDatum represents the datum format used by jpg.store bids and collection offers.
+// The payout to the bidder specifies the asset being bid on. For a collection offer, this is
+// a quantity of any asset under the collection policy, which is represented by an empty asset name
+type JpgStoreOfferDatum struct {
+	// Owner is the public key hash that is allowed to cancel the offer
+	Owner   []byte
+	Payouts []JpgStoreValuePayout
+}
+
+func (j *JpgStoreOfferDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			j.Owner,
+			encodeJpgStoreValuePayouts(j.Payouts),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (j *JpgStoreOfferDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &j.Owner, &j.Payouts)
+}
+
+func encodeJpgStoreValuePayouts(payouts []JpgStoreValuePayout) any {
+	if len(payouts) == 0 {
+		return []any{}
+	}
+	ret := make(cbor.IndefLengthList, 0, len(payouts))
+	for idx := range payouts {
+		ret = append(ret, &payouts[idx])
+	}
+	return ret
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+var testJpgStoreOwner = decodeHex("1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5")
+
+func TestJpgStoreV3AskDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799f9fd8799fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffff1a05a995c0ffd8799fd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ff1a004c4b40ffff581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ff"
+	expectedObj := models.JpgStoreV3AskDatum{
+		Payouts: []models.JpgStorePayout{
+			{
+				Address:        testPlutusAddressBase,
+				AmountLovelace: 95000000,
+			},
+			{
+				Address:        testPlutusAddressScript,
+				AmountLovelace: 5000000,
+			},
+		},
+		Owner: testJpgStoreOwner,
+	}
+	var testObj models.JpgStoreV3AskDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+	if testObj.Price() != 100000000 {
+		t.Fatalf("did not get expected price: got %d", testObj.Price())
+	}
+}
+
+func TestJpgStoreV2AskDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799f9fd8799fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffa140a1401a05a995c0ffff581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ff"
+	expectedObj := models.JpgStoreV2AskDatum{
+		Payouts: []models.JpgStoreValuePayout{
+			{
+				Address: testPlutusAddressBase,
+				Value: models.PlutusValue{
+					"": {"": 95000000},
+				},
+			},
+		},
+		Owner: testJpgStoreOwner,
+	}
+	var testObj models.JpgStoreV2AskDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+}
+
+func TestJpgStoreOfferDatumDecodeEncode(t *testing.T) {
+	policyId := string(decodeHex("4523c5e21d409b81c95b45b0aea275b8ea1406e6cafea5583b9f8a5f"))
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.JpgStoreOfferDatum
+	}{
+		{
+			// Collection offer
+			cborHex: "d8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c59fd8799fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffa1581c4523c5e21d409b81c95b45b0aea275b8ea1406e6cafea5583b9f8a5fa14001ffd8799fd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ffa140a1401a001e8480ffffff",
+			expectedObj: models.JpgStoreOfferDatum{
+				Owner: testJpgStoreOwner,
+				Payouts: []models.JpgStoreValuePayout{
+					{
+						Address: testPlutusAddressBase,
+						Value: models.PlutusValue{
+							policyId: {"": 1},
+						},
+					},
+					{
+						Address: testPlutusAddressScript,
+						Value: models.PlutusValue{
+							"": {"": 2000000},
+						},
+					},
+				},
+			},
+		},
+		{
+			// Bid on specific assets
+			cborHex: "d8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c59fd8799fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffa240a1401a0016e360581c4523c5e21d409b81c95b45b0aea275b8ea1406e6cafea5583b9f8a5fa345506978656c0147506978656c31320146506978656c3201ffffff",
+			expectedObj: models.JpgStoreOfferDatum{
+				Owner: testJpgStoreOwner,
+				Payouts: []models.JpgStoreValuePayout{
+					{
+						Address: testPlutusAddressBase,
+						Value: models.PlutusValue{
+							"": {"": 1500000},
+							policyId: {
+								"Pixel2":  1,
+								"Pixel12": 1,
+								"Pixel":   1,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.JpgStoreOfferDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
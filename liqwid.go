@@ -0,0 +1,123 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// LiqwidMarketStateDatum represents the state datum held by a Liqwid market. All amounts are
+// in the smallest unit of the market's underlying asset, except QTokens
+type LiqwidMarketStateDatum struct {
+	// Underlying asset available in the market for borrowing or withdrawal
+	Supply int64
+	// Underlying asset set aside for the protocol reserve
+	Reserve int64
+	// Circulating supply of the market's qToken
+	QTokens int64
+	// Outstanding borrow principal and the interest accrued on it
+	Principal int64
+	Interest  int64
+	// Cumulative interest index, used to calculate the interest owed by each loan
+	InterestIndexNumerator   int64
+	InterestIndexDenominator int64
+	// Current per-period interest rate
+	InterestRateNumerator   int64
+	InterestRateDenominator int64
+	// LastInterestTime is the POSIX time (in milliseconds) at which interest was last accrued
+	LastInterestTime int64
+}
+
+func (l *LiqwidMarketStateDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			l.Supply,
+			l.Reserve,
+			l.QTokens,
+			l.Principal,
+			l.Interest,
+			cbor.IndefLengthList{l.InterestIndexNumerator, l.InterestIndexDenominator},
+			cbor.IndefLengthList{l.InterestRateNumerator, l.InterestRateDenominator},
+			l.LastInterestTime,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (l *LiqwidMarketStateDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 8)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpInterestIndex, tmpInterestRate [2]int64
+	if err := decodePlutusFields(
+		fields,
+		&l.Supply,
+		&l.Reserve,
+		&l.QTokens,
+		&l.Principal,
+		&l.Interest,
+		&tmpInterestIndex,
+		&tmpInterestRate,
+		&l.LastInterestTime,
+	); err != nil {
+		return err
+	}
+	if tmpInterestIndex[1] == 0 || tmpInterestRate[1] == 0 {
+		return fmt.Errorf("invalid rational with zero denominator")
+	}
+	l.InterestIndexNumerator = tmpInterestIndex[0]
+	l.InterestIndexDenominator = tmpInterestIndex[1]
+	l.InterestRateNumerator = tmpInterestRate[0]
+	l.InterestRateDenominator = tmpInterestRate[1]
+	return nil
+}
+
+// ExchangeRate returns the amount of the underlying asset that a single qToken can be redeemed
+// for. It returns nil when there are no qTokens in circulation
+func (l LiqwidMarketStateDatum) ExchangeRate() *big.Rat {
+	if l.QTokens == 0 {
+		return nil
+	}
+	return big.NewRat(l.Supply+l.Principal+l.Interest-l.Reserve, l.QTokens)
+}
+
+// Utilization returns the fraction of the market's underlying asset that is currently borrowed.
+// It returns nil when the market is empty
+func (l LiqwidMarketStateDatum) Utilization() *big.Rat {
+	borrowed := l.Principal + l.Interest
+	total := l.Supply + borrowed
+	if total == 0 {
+		return nil
+	}
+	return big.NewRat(borrowed, total)
+}
+
+// InterestIndex returns the cumulative interest index of the market
+func (l LiqwidMarketStateDatum) InterestIndex() *big.Rat {
+	return big.NewRat(l.InterestIndexNumerator, l.InterestIndexDenominator)
+}
+
+// InterestRate returns the current interest rate of the market
+func (l LiqwidMarketStateDatum) InterestRate() *big.Rat {
+	return big.NewRat(l.InterestRateNumerator, l.InterestRateDenominator)
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"math/big"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestLiqwidMarketStateDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799f1b0000048c273950001b00000005d21dba001b0000da475abf00001b000002ba7def30001b0000000a7a3582009f1a0010121e1a000f4240ff9f19013d1b000000174876e800ff1b000001941f297c00ff"
+	expectedObj := models.LiqwidMarketStateDatum{
+		Supply:                   5000000000000,
+		Reserve:                  25000000000,
+		QTokens:                  240000000000000,
+		Principal:                3000000000000,
+		Interest:                 45000000000,
+		InterestIndexNumerator:   1053214,
+		InterestIndexDenominator: 1000000,
+		InterestRateNumerator:    317,
+		InterestRateDenominator:  100000000000,
+		LastInterestTime:         1735689600000,
+	}
+	var testObj models.LiqwidMarketStateDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+	if testObj.ExchangeRate().Cmp(big.NewRat(401, 12000)) != 0 {
+		t.Fatalf("did not get expected exchange rate: got %s", testObj.ExchangeRate())
+	}
+	if testObj.Utilization().Cmp(big.NewRat(609, 1609)) != 0 {
+		t.Fatalf("did not get expected utilization: got %s", testObj.Utilization())
+	}
+	if testObj.InterestIndex().Cmp(big.NewRat(1053214, 1000000)) != 0 {
+		t.Fatalf("did not get expected interest index: got %s", testObj.InterestIndex())
+	}
+}
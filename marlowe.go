@@ -0,0 +1,448 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// MarloweDatum represents the datum format used by the Marlowe semantics validator
+type MarloweDatum struct {
+	// RolesCurrency is the policy ID of the role tokens for the contract (MarloweParams)
+	RolesCurrency []byte
+	State         MarloweState
+	Contract      MarloweContract
+}
+
+func (m *MarloweDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			cbor.NewConstructor(0, cbor.IndefLengthList{m.RolesCurrency}),
+			&m.State,
+			&m.Contract,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MarloweDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 3)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	paramsConstr, paramsFields, err := decodePlutusConstr(fields[0], 1)
+	if err != nil {
+		return err
+	}
+	if paramsConstr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, paramsConstr)
+	}
+	if _, err := cbor.Decode(paramsFields[0], &m.RolesCurrency); err != nil {
+		return err
+	}
+	return decodePlutusFields(fields[1:], &m.State, &m.Contract)
+}
+
+// MarloweParty represents a participant in a Marlowe contract, identified by either an address
+// or a role token. Address is nil for a role party
+type MarloweParty struct {
+	Address *PlutusAddress
+	// Mainnet indicates the network of the address
+	Mainnet bool
+	// Role is the token name of the role token
+	Role []byte
+}
+
+func (m *MarloweParty) MarshalCBOR() ([]byte, error) {
+	var tmp cbor.Constructor
+	if m.Address != nil {
+		tmp = cbor.NewConstructor(
+			0,
+			cbor.IndefLengthList{
+				encodePlutusBool(m.Mainnet),
+				m.Address,
+			},
+		)
+	} else {
+		tmp = cbor.NewConstructor(1, cbor.IndefLengthList{m.Role})
+	}
+	return cbor.Encode(&tmp)
+}
+
+func (m *MarloweParty) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*m = MarloweParty{}
+	switch constr {
+	case 0:
+		var tmpAddress PlutusAddress
+		if err := decodePlutusFields(fields, &m.Mainnet, &tmpAddress); err != nil {
+			return err
+		}
+		m.Address = &tmpAddress
+		return nil
+	case 1:
+		return decodePlutusFields(fields, &m.Role)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// MarloweAccount represents the balance of a token in an internal Marlowe account
+type MarloweAccount struct {
+	Party  MarloweParty
+	Token  PlutusAssetClass
+	Amount int64
+}
+
+// MarloweChoiceId identifies a choice made by a party
+type MarloweChoiceId struct {
+	Name  []byte
+	Owner MarloweParty
+}
+
+func (m *MarloweChoiceId) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			m.Name,
+			&m.Owner,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MarloweChoiceId) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &m.Name, &m.Owner)
+}
+
+// MarloweChoice represents the value recorded for a choice
+type MarloweChoice struct {
+	Id    MarloweChoiceId
+	Value int64
+}
+
+// MarloweBoundValue represents a value bound by a Let contract
+type MarloweBoundValue struct {
+	Id    []byte
+	Value int64
+}
+
+// MarloweState represents the state of a Marlowe contract. The on-chain maps are represented
+// as slices, which preserves the order of the entries
+type MarloweState struct {
+	Accounts    []MarloweAccount
+	Choices     []MarloweChoice
+	BoundValues []MarloweBoundValue
+	// MinTime is the POSIX time (in milliseconds) that the contract was last applied at
+	MinTime int64
+}
+
+func (m *MarloweState) MarshalCBOR() ([]byte, error) {
+	accounts := make([][2]any, 0, len(m.Accounts))
+	for idx := range m.Accounts {
+		account := &m.Accounts[idx]
+		accounts = append(
+			accounts,
+			[2]any{
+				cbor.NewConstructor(
+					0,
+					cbor.IndefLengthList{
+						&account.Party,
+						&account.Token,
+					},
+				),
+				account.Amount,
+			},
+		)
+	}
+	choices := make([][2]any, 0, len(m.Choices))
+	for idx := range m.Choices {
+		choices = append(choices, [2]any{&m.Choices[idx].Id, m.Choices[idx].Value})
+	}
+	boundValues := make([][2]any, 0, len(m.BoundValues))
+	for _, boundValue := range m.BoundValues {
+		boundValues = append(boundValues, [2]any{boundValue.Id, boundValue.Value})
+	}
+	fields := cbor.IndefLengthList{}
+	for _, entries := range [][][2]any{accounts, choices, boundValues} {
+		mapCbor, err := encodePlutusMap(entries)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, cbor.RawMessage(mapCbor))
+	}
+	fields = append(fields, m.MinTime)
+	tmp := cbor.NewConstructor(0, fields)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MarloweState) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 4)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	*m = MarloweState{}
+	accounts, err := decodePlutusMap(fields[0])
+	if err != nil {
+		return err
+	}
+	for _, entry := range accounts {
+		var tmpAccount MarloweAccount
+		keyConstr, keyFields, err := decodePlutusConstr(entry[0], 2)
+		if err != nil {
+			return err
+		}
+		if keyConstr != 0 {
+			return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, keyConstr)
+		}
+		if err := decodePlutusFields(keyFields, &tmpAccount.Party, &tmpAccount.Token); err != nil {
+			return err
+		}
+		if _, err := cbor.Decode(entry[1], &tmpAccount.Amount); err != nil {
+			return err
+		}
+		m.Accounts = append(m.Accounts, tmpAccount)
+	}
+	choices, err := decodePlutusMap(fields[1])
+	if err != nil {
+		return err
+	}
+	for _, entry := range choices {
+		var tmpChoice MarloweChoice
+		if err := decodePlutusFields(entry[:], &tmpChoice.Id, &tmpChoice.Value); err != nil {
+			return err
+		}
+		m.Choices = append(m.Choices, tmpChoice)
+	}
+	boundValues, err := decodePlutusMap(fields[2])
+	if err != nil {
+		return err
+	}
+	for _, entry := range boundValues {
+		var tmpBoundValue MarloweBoundValue
+		if err := decodePlutusFields(entry[:], &tmpBoundValue.Id, &tmpBoundValue.Value); err != nil {
+			return err
+		}
+		m.BoundValues = append(m.BoundValues, tmpBoundValue)
+	}
+	if _, err := cbor.Decode(fields[3], &m.MinTime); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarlowePayeeType identifies whether a Marlowe payment goes to an internal account or to a party
+type MarlowePayeeType uint
+
+const (
+	MarlowePayeeAccount MarlowePayeeType = 0
+	MarlowePayeeParty   MarlowePayeeType = 1
+)
+
+// MarlowePayee represents the recipient of a Marlowe payment
+type MarlowePayee struct {
+	Type  MarlowePayeeType
+	Party MarloweParty
+}
+
+func (m *MarlowePayee) MarshalCBOR() ([]byte, error) {
+	switch m.Type {
+	case MarlowePayeeAccount, MarlowePayeeParty:
+	default:
+		return nil, fmt.Errorf("unknown payee type: %d", m.Type)
+	}
+	tmp := cbor.NewConstructor(uint(m.Type), cbor.IndefLengthList{&m.Party})
+	return cbor.Encode(&tmp)
+}
+
+func (m *MarlowePayee) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 1)
+	if err != nil {
+		return err
+	}
+	switch MarlowePayeeType(constr) {
+	case MarlowePayeeAccount, MarlowePayeeParty:
+		m.Type = MarlowePayeeType(constr)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &m.Party)
+}
+
+// MarloweContractType identifies the type of a Marlowe contract term
+type MarloweContractType uint
+
+const (
+	MarloweContractClose  MarloweContractType = 0
+	MarloweContractPay    MarloweContractType = 1
+	MarloweContractIf     MarloweContractType = 2
+	MarloweContractWhen   MarloweContractType = 3
+	MarloweContractLet    MarloweContractType = 4
+	MarloweContractAssert MarloweContractType = 5
+)
+
+// MarloweContract represents a Marlowe contract term and its continuations. Only the fields
+// relevant to the type are used. Values, observations and actions are kept as raw Plutus data
+type MarloweContract struct {
+	Type MarloweContractType
+	// Used by Pay
+	AccountId MarloweParty
+	Payee     MarlowePayee
+	Token     PlutusAssetClass
+	// Used by Pay and Let
+	Value cbor.RawMessage
+	// Used by If and Assert
+	Observation cbor.RawMessage
+	// Used by If
+	Then *MarloweContract
+	Else *MarloweContract
+	// Used by When
+	Cases   []MarloweCase
+	Timeout int64
+	// Used by Let
+	ValueId []byte
+	// Continuation is the contract that follows a Pay, Let or Assert, or the timeout
+	// continuation of a When
+	Continuation *MarloweContract
+}
+
+func (m *MarloweContract) MarshalCBOR() ([]byte, error) {
+	var fields any
+	switch m.Type {
+	case MarloweContractClose:
+		fields = []any{}
+	case MarloweContractPay:
+		fields = cbor.IndefLengthList{
+			&m.AccountId,
+			&m.Payee,
+			&m.Token,
+			m.Value,
+			m.Continuation,
+		}
+	case MarloweContractIf:
+		fields = cbor.IndefLengthList{m.Observation, m.Then, m.Else}
+	case MarloweContractWhen:
+		var cases any = []any{}
+		if len(m.Cases) > 0 {
+			tmpList := make(cbor.IndefLengthList, 0, len(m.Cases))
+			for idx := range m.Cases {
+				tmpList = append(tmpList, &m.Cases[idx])
+			}
+			cases = tmpList
+		}
+		fields = cbor.IndefLengthList{cases, m.Timeout, m.Continuation}
+	case MarloweContractLet:
+		fields = cbor.IndefLengthList{m.ValueId, m.Value, m.Continuation}
+	case MarloweContractAssert:
+		fields = cbor.IndefLengthList{m.Observation, m.Continuation}
+	default:
+		return nil, fmt.Errorf("unknown contract type: %d", m.Type)
+	}
+	tmp := cbor.NewConstructor(uint(m.Type), fields)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MarloweContract) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*m = MarloweContract{
+		Type: MarloweContractType(constr),
+	}
+	switch m.Type {
+	case MarloweContractClose:
+		return decodePlutusFields(fields)
+	case MarloweContractPay:
+		m.Continuation = &MarloweContract{}
+		return decodePlutusFields(
+			fields,
+			&m.AccountId,
+			&m.Payee,
+			&m.Token,
+			&m.Value,
+			m.Continuation,
+		)
+	case MarloweContractIf:
+		m.Then = &MarloweContract{}
+		m.Else = &MarloweContract{}
+		return decodePlutusFields(fields, &m.Observation, m.Then, m.Else)
+	case MarloweContractWhen:
+		m.Continuation = &MarloweContract{}
+		return decodePlutusFields(fields, &m.Cases, &m.Timeout, m.Continuation)
+	case MarloweContractLet:
+		m.Continuation = &MarloweContract{}
+		return decodePlutusFields(fields, &m.ValueId, &m.Value, m.Continuation)
+	case MarloweContractAssert:
+		m.Continuation = &MarloweContract{}
+		return decodePlutusFields(fields, &m.Observation, m.Continuation)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// MarloweCase represents a possible action within a When contract. For a merkleized case,
+// Contract is nil and ContinuationHash identifies the continuation contract
+type MarloweCase struct {
+	Action           cbor.RawMessage
+	Contract         *MarloweContract
+	ContinuationHash []byte
+}
+
+func (m *MarloweCase) MarshalCBOR() ([]byte, error) {
+	var tmp cbor.Constructor
+	if m.Contract != nil {
+		tmp = cbor.NewConstructor(0, cbor.IndefLengthList{m.Action, m.Contract})
+	} else {
+		tmp = cbor.NewConstructor(1, cbor.IndefLengthList{m.Action, m.ContinuationHash})
+	}
+	return cbor.Encode(&tmp)
+}
+
+func (m *MarloweCase) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	*m = MarloweCase{}
+	switch constr {
+	case 0:
+		m.Contract = &MarloweContract{}
+		return decodePlutusFields(fields, &m.Action, m.Contract)
+	case 1:
+		return decodePlutusFields(fields, &m.Action, &m.ContinuationHash)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
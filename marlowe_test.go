@@ -0,0 +1,126 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"bytes"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+func TestMarloweDatumDecodeEncode(t *testing.T) {
+	rolesCurrency := decodeHex("cccccccccccccccccccccccccccccccccccccccccccccccccccccccc")
+	seller := models.MarloweParty{
+		Role: []byte("Seller"),
+	}
+	buyer := models.MarloweParty{
+		Address: &testPlutusAddressBase,
+		Mainnet: true,
+	}
+	closeContract := &models.MarloweContract{
+		Type: models.MarloweContractClose,
+	}
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.MarloweDatum
+	}{
+		{
+			cborHex: "d8799fd8799f581cccccccccccccccccccccccccccccccccccccccccccccccccccccccccffd8799fa1d8799fd87a9f4653656c6c6572ffd8799f4040ffff1a001e8480a1d8799f47617070726f7665d8799fd87a80d8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffffff01a1457072696365051b000001941f297c00ffd87c9f9fd8799fd8799fd87a9f4653656c6c6572ffd8799fd87a80d8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffffd8799f4040ffd87a9f1a05f5e100ffffd87a9fd87a9f4653656c6c6572ffd87a9fd8799fd87a80d8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffffffd8799f4040ffd8799fd87a9f4653656c6c6572ffd8799f4040ffffd87d9f457072696365d87a9f05ffd87e9fd9050280d87b9fd9050380d87980d87980ffffffffffd87a9fd87b9fd8799f47617070726f7665d8799fd87a80d8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffffff9fd8799f0001ffffff5820ababababababababababababababababababababababababababababababababffff1b000001941f606a80d87980ffff",
+			expectedObj: models.MarloweDatum{
+				RolesCurrency: rolesCurrency,
+				State: models.MarloweState{
+					Accounts: []models.MarloweAccount{
+						{
+							Party:  seller,
+							Token:  testLovelaceAsset,
+							Amount: 2000000,
+						},
+					},
+					Choices: []models.MarloweChoice{
+						{
+							Id: models.MarloweChoiceId{
+								Name:  []byte("approve"),
+								Owner: buyer,
+							},
+							Value: 1,
+						},
+					},
+					BoundValues: []models.MarloweBoundValue{
+						{
+							Id:    []byte("price"),
+							Value: 5,
+						},
+					},
+					MinTime: 1735689600000,
+				},
+				Contract: models.MarloweContract{
+					Type: models.MarloweContractWhen,
+					Cases: []models.MarloweCase{
+						{
+							Action: cbor.RawMessage(decodeHex("d8799fd87a9f4653656c6c6572ffd8799fd87a80d8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffffd8799f4040ffd87a9f1a05f5e100ffff")),
+							Contract: &models.MarloweContract{
+								Type:      models.MarloweContractPay,
+								AccountId: seller,
+								Payee: models.MarlowePayee{
+									Type:  models.MarlowePayeeParty,
+									Party: buyer,
+								},
+								Token: testLovelaceAsset,
+								Value: cbor.RawMessage(decodeHex("d8799fd87a9f4653656c6c6572ffd8799f4040ffff")),
+								Continuation: &models.MarloweContract{
+									Type:    models.MarloweContractLet,
+									ValueId: []byte("price"),
+									Value:   cbor.RawMessage(decodeHex("d87a9f05ff")),
+									Continuation: &models.MarloweContract{
+										Type:        models.MarloweContractAssert,
+										Observation: cbor.RawMessage(decodeHex("d9050280")),
+										Continuation: &models.MarloweContract{
+											Type:        models.MarloweContractIf,
+											Observation: cbor.RawMessage(decodeHex("d9050380")),
+											Then:        closeContract,
+											Else:        closeContract,
+										},
+									},
+								},
+							},
+						},
+						{
+							// Merkleized case
+							Action:           cbor.RawMessage(decodeHex("d87b9fd8799f47617070726f7665d8799fd87a80d8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffffffff9fd8799f0001ffffff")),
+							ContinuationHash: bytes.Repeat([]byte{0xab}, 32),
+						},
+					},
+					Timeout:      1735693200000,
+					Continuation: closeContract,
+				},
+			},
+		},
+		{
+			// Closed contract with empty state
+			cborHex: "d8799fd8799f581cccccccccccccccccccccccccccccccccccccccccccccccccccccccccffd8799fa0a0a000ffd87980ff",
+			expectedObj: models.MarloweDatum{
+				RolesCurrency: rolesCurrency,
+				Contract:      *closeContract,
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.MarloweDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
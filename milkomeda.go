@@ -0,0 +1,65 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"log/slog"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Transaction metadata labels used by the Milkomeda bridge
+const (
+	MilkomedaProtocolMagicMetadataLabel = 87
+	MilkomedaAddressMetadataLabel       = 88
+)
+
+// MilkomedaMetadata represents the metadata envelope attached to Milkomeda wrap and unwrap
+// transactions, which identifies the sidechain and the destination address on it
+type MilkomedaMetadata struct {
+	// ProtocolMagic identifies the sidechain instance (e.g. the C1 mainnet)
+	ProtocolMagic string `cbor:"87,keyasint" json:"87" validate:"required,max=64"`
+	// Address is the destination account on the sidechain
+	Address string `cbor:"88,keyasint" json:"88" validate:"required,max=64"`
+}
+
+// UnmarshalYAML decodes the metadata from YAML, using the same fields as UnmarshalJSON
+func (m *MilkomedaMetadata) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYamlViaJson(node, m)
+}
+
+// MarshalYAML encodes the metadata as YAML, using the same fields as MarshalJSON
+func (m MilkomedaMetadata) MarshalYAML() (any, error) {
+	return marshalYamlViaJson(m)
+}
+
+// LogValue summarizes the metadata for structured logging
+func (m MilkomedaMetadata) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("protocolMagic", m.ProtocolMagic),
+		slog.String("address", m.Address),
+	)
+}
+
+func (m *MilkomedaMetadata) Validate() error {
+	if m.ProtocolMagic == "" {
+		return fmt.Errorf("%w %d in Milkomeda metadata", ErrMissingLabel, MilkomedaProtocolMagicMetadataLabel)
+	}
+	if m.Address == "" {
+		return fmt.Errorf("%w %d in Milkomeda metadata", ErrMissingLabel, MilkomedaAddressMetadataLabel)
+	}
+	return packageValidator().Struct(m)
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMilkomedaMetadataDecodeEncode(t *testing.T) {
+	testCborHex := "a218576a6331206d61696e6e65741858782a307838313031333737653838623037363438343137643235356631356437663332646334613535646236"
+	expectedObj := models.MilkomedaMetadata{
+		ProtocolMagic: "c1 mainnet",
+		Address:       "0x8101377e88b07648417d255f15d7f32dc4a55db6",
+	}
+	cborData, err := hex.DecodeString(testCborHex)
+	require.NoError(t, err)
+	var testObj models.MilkomedaMetadata
+	_, err = cbor.Decode(cborData, &testObj)
+	require.NoError(t, err)
+	require.Equal(t, expectedObj, testObj)
+	require.NoError(t, testObj.Validate())
+	encoded, err := cbor.Encode(&testObj)
+	require.NoError(t, err)
+	require.Equal(t, testCborHex, hex.EncodeToString(encoded))
+}
+
+func TestMilkomedaMetadataValidate(t *testing.T) {
+	testObj := models.MilkomedaMetadata{
+		ProtocolMagic: "c1 mainnet",
+	}
+	require.Error(t, testObj.Validate())
+}
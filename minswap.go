@@ -0,0 +1,744 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+const (
+	// MinswapV1LpPolicyId is the policy ID of the Minswap V1 LP tokens. The asset name
+	// of a pool's LP token matches the asset name of its pool NFT
+	MinswapV1LpPolicyId = "e4214b7cce62ac6fbba385d164df48e157eae5863521b4b67ca71d86"
+	// MinswapV1PoolNftPolicyId is the policy ID of the Minswap V1 pool NFTs
+	MinswapV1PoolNftPolicyId = "0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb1"
+)
+
+// MinswapV1PoolDatum represents the datum format used by the Minswap V1 pool contract
+type MinswapV1PoolDatum struct {
+	AssetA         PlutusAssetClass
+	AssetB         PlutusAssetClass
+	TotalLiquidity int64
+	RootKLast      int64
+	// FeeSharing is nil when protocol fee sharing is disabled for the pool
+	FeeSharing *MinswapV1FeeSharing
+}
+
+// MinswapV1FeeSharing specifies where the protocol fee share for a pool is sent
+type MinswapV1FeeSharing struct {
+	FeeTo          PlutusAddress
+	FeeToDatumHash []byte
+}
+
+func (m *MinswapV1PoolDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&m.AssetA,
+			&m.AssetB,
+			m.TotalLiquidity,
+			m.RootKLast,
+			encodePlutusMaybe(m.FeeSharing),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV1PoolDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 5)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpFeeSharing cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&m.AssetA,
+		&m.AssetB,
+		&m.TotalLiquidity,
+		&m.RootKLast,
+		&tmpFeeSharing,
+	); err != nil {
+		return err
+	}
+	feeSharing, err := decodePlutusMaybe[MinswapV1FeeSharing](tmpFeeSharing)
+	if err != nil {
+		return err
+	}
+	m.FeeSharing = feeSharing
+	return nil
+}
+
+// Reserves returns the pool reserves of asset A and asset B from the pool UTxO value
+func (m MinswapV1PoolDatum) Reserves(value PlutusValue) (int64, int64) {
+	return value.AssetAmount(m.AssetA), value.AssetAmount(m.AssetB)
+}
+
+// LpAsset returns the LP token asset class for a pool, given the asset name of its pool NFT
+func (m MinswapV1PoolDatum) LpAsset(poolNftName []byte) PlutusAssetClass {
+	policyId, _ := hex.DecodeString(MinswapV1LpPolicyId)
+	return PlutusAssetClass{
+		PolicyId:  policyId,
+		AssetName: poolNftName,
+	}
+}
+
+func (m *MinswapV1FeeSharing) MarshalCBOR() ([]byte, error) {
+	var datumHash *[]byte
+	if m.FeeToDatumHash != nil {
+		datumHash = &m.FeeToDatumHash
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&m.FeeTo,
+			encodePlutusMaybe(datumHash),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV1FeeSharing) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpDatumHash cbor.RawMessage
+	if err := decodePlutusFields(fields, &m.FeeTo, &tmpDatumHash); err != nil {
+		return err
+	}
+	datumHash, err := decodePlutusMaybe[[]byte](tmpDatumHash)
+	if err != nil {
+		return err
+	}
+	m.FeeToDatumHash = nil
+	if datumHash != nil {
+		m.FeeToDatumHash = *datumHash
+	}
+	return nil
+}
+
+// MinswapV1OrderStepType identifies the type of a Minswap V1 order
+type MinswapV1OrderStepType uint
+
+const (
+	MinswapV1OrderStepSwapExactIn    MinswapV1OrderStepType = 0
+	MinswapV1OrderStepSwapExactOut   MinswapV1OrderStepType = 1
+	MinswapV1OrderStepDeposit        MinswapV1OrderStepType = 2
+	MinswapV1OrderStepWithdraw       MinswapV1OrderStepType = 3
+	MinswapV1OrderStepOneSideDeposit MinswapV1OrderStepType = 4
+)
+
+// MinswapV1OrderStep represents the action requested by a Minswap V1 order. Only the
+// fields relevant to the step type are used
+type MinswapV1OrderStep struct {
+	Type MinswapV1OrderStepType
+	// Used by SwapExactIn, SwapExactOut and OneSideDeposit
+	DesiredAsset PlutusAssetClass
+	// Minimum received for SwapExactIn, expected received for SwapExactOut
+	Amount int64
+	// Used by Deposit and OneSideDeposit
+	MinimumLp int64
+	// Used by Withdraw
+	MinimumAssetA int64
+	MinimumAssetB int64
+}
+
+func (m *MinswapV1OrderStep) MarshalCBOR() ([]byte, error) {
+	var fields cbor.IndefLengthList
+	switch m.Type {
+	case MinswapV1OrderStepSwapExactIn, MinswapV1OrderStepSwapExactOut:
+		fields = cbor.IndefLengthList{&m.DesiredAsset, m.Amount}
+	case MinswapV1OrderStepDeposit:
+		fields = cbor.IndefLengthList{m.MinimumLp}
+	case MinswapV1OrderStepWithdraw:
+		fields = cbor.IndefLengthList{m.MinimumAssetA, m.MinimumAssetB}
+	case MinswapV1OrderStepOneSideDeposit:
+		fields = cbor.IndefLengthList{&m.DesiredAsset, m.MinimumLp}
+	default:
+		return nil, fmt.Errorf("unknown order step type: %d", m.Type)
+	}
+	tmp := cbor.NewConstructor(uint(m.Type), fields)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV1OrderStep) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*m = MinswapV1OrderStep{
+		Type: MinswapV1OrderStepType(constr),
+	}
+	switch m.Type {
+	case MinswapV1OrderStepSwapExactIn, MinswapV1OrderStepSwapExactOut:
+		return decodePlutusFields(fields, &m.DesiredAsset, &m.Amount)
+	case MinswapV1OrderStepDeposit:
+		return decodePlutusFields(fields, &m.MinimumLp)
+	case MinswapV1OrderStepWithdraw:
+		return decodePlutusFields(fields, &m.MinimumAssetA, &m.MinimumAssetB)
+	case MinswapV1OrderStepOneSideDeposit:
+		return decodePlutusFields(fields, &m.DesiredAsset, &m.MinimumLp)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// MinswapV1OrderDatum represents the datum format used by the Minswap V1 order (batch) contract
+type MinswapV1OrderDatum struct {
+	Sender   PlutusAddress
+	Receiver PlutusAddress
+	// ReceiverDatumHash is nil when the output to the receiver has no datum
+	ReceiverDatumHash []byte
+	Step              MinswapV1OrderStep
+	BatcherFee        int64
+	OutputAda         int64
+}
+
+func (m *MinswapV1OrderDatum) MarshalCBOR() ([]byte, error) {
+	var datumHash *[]byte
+	if m.ReceiverDatumHash != nil {
+		datumHash = &m.ReceiverDatumHash
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&m.Sender,
+			&m.Receiver,
+			encodePlutusMaybe(datumHash),
+			&m.Step,
+			m.BatcherFee,
+			m.OutputAda,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV1OrderDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 6)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpDatumHash cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&m.Sender,
+		&m.Receiver,
+		&tmpDatumHash,
+		&m.Step,
+		&m.BatcherFee,
+		&m.OutputAda,
+	); err != nil {
+		return err
+	}
+	datumHash, err := decodePlutusMaybe[[]byte](tmpDatumHash)
+	if err != nil {
+		return err
+	}
+	m.ReceiverDatumHash = nil
+	if datumHash != nil {
+		m.ReceiverDatumHash = *datumHash
+	}
+	return nil
+}
+
+// MinswapV2PoolDatum represents the datum format used by the Minswap V2 pool contract
+type MinswapV2PoolDatum struct {
+	PoolBatchingStakeCredential PlutusStakingCredential
+	AssetA                      PlutusAssetClass
+	AssetB                      PlutusAssetClass
+	TotalLiquidity              int64
+	ReserveA                    int64
+	ReserveB                    int64
+	BaseFeeANumerator           int64
+	BaseFeeBNumerator           int64
+	// FeeSharingNumerator is nil when protocol fee sharing is disabled for the pool
+	FeeSharingNumerator *int64
+	AllowDynamicFee     bool
+}
+
+// MinswapV2FeeDenominator is the denominator used for the Minswap V2 pool fee numerators
+const MinswapV2FeeDenominator = 10000
+
+func (m *MinswapV2PoolDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&m.PoolBatchingStakeCredential,
+			&m.AssetA,
+			&m.AssetB,
+			m.TotalLiquidity,
+			m.ReserveA,
+			m.ReserveB,
+			m.BaseFeeANumerator,
+			m.BaseFeeBNumerator,
+			encodePlutusMaybe(m.FeeSharingNumerator),
+			encodePlutusBool(m.AllowDynamicFee),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV2PoolDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 10)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpFeeSharing cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&m.PoolBatchingStakeCredential,
+		&m.AssetA,
+		&m.AssetB,
+		&m.TotalLiquidity,
+		&m.ReserveA,
+		&m.ReserveB,
+		&m.BaseFeeANumerator,
+		&m.BaseFeeBNumerator,
+		&tmpFeeSharing,
+		&m.AllowDynamicFee,
+	); err != nil {
+		return err
+	}
+	feeSharing, err := decodePlutusMaybe[int64](tmpFeeSharing)
+	if err != nil {
+		return err
+	}
+	m.FeeSharingNumerator = feeSharing
+	return nil
+}
+
+// MinswapV2AuthorizationMethodType identifies how a Minswap V2 order can be cancelled
+type MinswapV2AuthorizationMethodType uint
+
+const (
+	MinswapV2AuthorizationSignature      MinswapV2AuthorizationMethodType = 0
+	MinswapV2AuthorizationSpendScript    MinswapV2AuthorizationMethodType = 1
+	MinswapV2AuthorizationWithdrawScript MinswapV2AuthorizationMethodType = 2
+	MinswapV2AuthorizationMintScript     MinswapV2AuthorizationMethodType = 3
+)
+
+// MinswapV2AuthorizationMethod specifies the key or script hash which is authorized to cancel
+// a Minswap V2 order
+type MinswapV2AuthorizationMethod struct {
+	Type MinswapV2AuthorizationMethodType
+	Hash []byte
+}
+
+func (m *MinswapV2AuthorizationMethod) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		uint(m.Type),
+		cbor.IndefLengthList{
+			m.Hash,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV2AuthorizationMethod) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 1)
+	if err != nil {
+		return err
+	}
+	if constr > uint(MinswapV2AuthorizationMintScript) {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	m.Type = MinswapV2AuthorizationMethodType(constr)
+	return decodePlutusFields(fields, &m.Hash)
+}
+
+// MinswapV2ExtraDatumType identifies the kind of datum attached to an order output
+type MinswapV2ExtraDatumType uint
+
+const (
+	MinswapV2ExtraDatumNone   MinswapV2ExtraDatumType = 0
+	MinswapV2ExtraDatumHash   MinswapV2ExtraDatumType = 1
+	MinswapV2ExtraDatumInline MinswapV2ExtraDatumType = 2
+)
+
+// MinswapV2ExtraDatum specifies the datum to attach to an output created by the batcher
+type MinswapV2ExtraDatum struct {
+	Type MinswapV2ExtraDatumType
+	// Hash is not used when Type is MinswapV2ExtraDatumNone
+	Hash []byte
+}
+
+func (m *MinswapV2ExtraDatum) MarshalCBOR() ([]byte, error) {
+	var tmp cbor.Constructor
+	switch m.Type {
+	case MinswapV2ExtraDatumNone:
+		tmp = cbor.NewConstructor(uint(m.Type), []any{})
+	case MinswapV2ExtraDatumHash, MinswapV2ExtraDatumInline:
+		tmp = cbor.NewConstructor(uint(m.Type), cbor.IndefLengthList{m.Hash})
+	default:
+		return nil, fmt.Errorf("unknown extra datum type: %d", m.Type)
+	}
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV2ExtraDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*m = MinswapV2ExtraDatum{
+		Type: MinswapV2ExtraDatumType(constr),
+	}
+	switch m.Type {
+	case MinswapV2ExtraDatumNone:
+		return decodePlutusFields(fields)
+	case MinswapV2ExtraDatumHash, MinswapV2ExtraDatumInline:
+		return decodePlutusFields(fields, &m.Hash)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// MinswapV2AmountOption specifies either a specific amount, or all available funds minus
+// the specified deducted amount
+type MinswapV2AmountOption struct {
+	All    bool
+	Amount int64
+}
+
+func (m *MinswapV2AmountOption) MarshalCBOR() ([]byte, error) {
+	var constr uint
+	if m.All {
+		constr = 1
+	}
+	tmp := cbor.NewConstructor(constr, cbor.IndefLengthList{m.Amount})
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV2AmountOption) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 1)
+	if err != nil {
+		return err
+	}
+	if constr > 1 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	m.All = constr == 1
+	return decodePlutusFields(fields, &m.Amount)
+}
+
+// MinswapV2DepositAmountOption specifies the deposit amounts of both assets for a Minswap V2
+// deposit order, either as specific amounts or all available funds minus the deducted amounts
+type MinswapV2DepositAmountOption struct {
+	All     bool
+	AmountA int64
+	AmountB int64
+}
+
+func (m *MinswapV2DepositAmountOption) MarshalCBOR() ([]byte, error) {
+	var constr uint
+	if m.All {
+		constr = 1
+	}
+	tmp := cbor.NewConstructor(constr, cbor.IndefLengthList{m.AmountA, m.AmountB})
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV2DepositAmountOption) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr > 1 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	m.All = constr == 1
+	return decodePlutusFields(fields, &m.AmountA, &m.AmountB)
+}
+
+// MinswapV2OrderStepType identifies the type of a Minswap V2 order
+type MinswapV2OrderStepType uint
+
+const (
+	MinswapV2OrderStepSwapExactIn  MinswapV2OrderStepType = 0
+	MinswapV2OrderStepStopLoss     MinswapV2OrderStepType = 1
+	MinswapV2OrderStepOco          MinswapV2OrderStepType = 2
+	MinswapV2OrderStepSwapExactOut MinswapV2OrderStepType = 3
+	MinswapV2OrderStepDeposit      MinswapV2OrderStepType = 4
+	MinswapV2OrderStepWithdraw     MinswapV2OrderStepType = 5
+	MinswapV2OrderStepZapOut       MinswapV2OrderStepType = 6
+	MinswapV2OrderStepDonation     MinswapV2OrderStepType = 10
+)
+
+// MinswapV2OrderStep represents the action requested by a Minswap V2 order. Only the fields
+// relevant to the step type are used. The partial swap, imbalanced withdrawal and routing
+// swap steps are not currently supported
+type MinswapV2OrderStep struct {
+	Type MinswapV2OrderStepType
+	// AToB is true when swapping asset A for asset B. Used by the swap steps and ZapOut
+	AToB bool
+	// Amount to swap for the swap steps (the maximum amount for SwapExactOut)
+	SwapAmount MinswapV2AmountOption
+	// Used by SwapExactIn, OCO and ZapOut
+	MinimumReceive int64
+	// Used by StopLoss and OCO
+	StopLossReceive int64
+	// Used by SwapExactOut
+	ExpectedReceive int64
+	// Used by Deposit
+	DepositAmount MinswapV2DepositAmountOption
+	MinimumLp     int64
+	// Used by Withdraw and ZapOut
+	WithdrawalAmount MinswapV2AmountOption
+	// Used by Withdraw
+	MinimumAssetA int64
+	MinimumAssetB int64
+	// Killable is true if the order should be cancelled rather than left pending when it
+	// cannot be filled. Used by SwapExactIn, SwapExactOut, Deposit, Withdraw and ZapOut
+	Killable bool
+}
+
+func (m *MinswapV2OrderStep) MarshalCBOR() ([]byte, error) {
+	var fields cbor.IndefLengthList
+	switch m.Type {
+	case MinswapV2OrderStepSwapExactIn:
+		fields = cbor.IndefLengthList{
+			encodePlutusBool(m.AToB),
+			&m.SwapAmount,
+			m.MinimumReceive,
+			encodePlutusBool(m.Killable),
+		}
+	case MinswapV2OrderStepStopLoss:
+		fields = cbor.IndefLengthList{
+			encodePlutusBool(m.AToB),
+			&m.SwapAmount,
+			m.StopLossReceive,
+		}
+	case MinswapV2OrderStepOco:
+		fields = cbor.IndefLengthList{
+			encodePlutusBool(m.AToB),
+			&m.SwapAmount,
+			m.MinimumReceive,
+			m.StopLossReceive,
+		}
+	case MinswapV2OrderStepSwapExactOut:
+		fields = cbor.IndefLengthList{
+			encodePlutusBool(m.AToB),
+			&m.SwapAmount,
+			m.ExpectedReceive,
+			encodePlutusBool(m.Killable),
+		}
+	case MinswapV2OrderStepDeposit:
+		fields = cbor.IndefLengthList{
+			&m.DepositAmount,
+			m.MinimumLp,
+			encodePlutusBool(m.Killable),
+		}
+	case MinswapV2OrderStepWithdraw:
+		fields = cbor.IndefLengthList{
+			&m.WithdrawalAmount,
+			m.MinimumAssetA,
+			m.MinimumAssetB,
+			encodePlutusBool(m.Killable),
+		}
+	case MinswapV2OrderStepZapOut:
+		fields = cbor.IndefLengthList{
+			encodePlutusBool(m.AToB),
+			&m.WithdrawalAmount,
+			m.MinimumReceive,
+			encodePlutusBool(m.Killable),
+		}
+	case MinswapV2OrderStepDonation:
+		tmp := cbor.NewConstructor(uint(m.Type), []any{})
+		return cbor.Encode(&tmp)
+	default:
+		return nil, fmt.Errorf("unsupported order step type: %d", m.Type)
+	}
+	tmp := cbor.NewConstructor(uint(m.Type), fields)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV2OrderStep) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*m = MinswapV2OrderStep{
+		Type: MinswapV2OrderStepType(constr),
+	}
+	switch m.Type {
+	case MinswapV2OrderStepSwapExactIn:
+		return decodePlutusFields(
+			fields,
+			&m.AToB,
+			&m.SwapAmount,
+			&m.MinimumReceive,
+			&m.Killable,
+		)
+	case MinswapV2OrderStepStopLoss:
+		return decodePlutusFields(
+			fields,
+			&m.AToB,
+			&m.SwapAmount,
+			&m.StopLossReceive,
+		)
+	case MinswapV2OrderStepOco:
+		return decodePlutusFields(
+			fields,
+			&m.AToB,
+			&m.SwapAmount,
+			&m.MinimumReceive,
+			&m.StopLossReceive,
+		)
+	case MinswapV2OrderStepSwapExactOut:
+		return decodePlutusFields(
+			fields,
+			&m.AToB,
+			&m.SwapAmount,
+			&m.ExpectedReceive,
+			&m.Killable,
+		)
+	case MinswapV2OrderStepDeposit:
+		return decodePlutusFields(
+			fields,
+			&m.DepositAmount,
+			&m.MinimumLp,
+			&m.Killable,
+		)
+	case MinswapV2OrderStepWithdraw:
+		return decodePlutusFields(
+			fields,
+			&m.WithdrawalAmount,
+			&m.MinimumAssetA,
+			&m.MinimumAssetB,
+			&m.Killable,
+		)
+	case MinswapV2OrderStepZapOut:
+		return decodePlutusFields(
+			fields,
+			&m.AToB,
+			&m.WithdrawalAmount,
+			&m.MinimumReceive,
+			&m.Killable,
+		)
+	case MinswapV2OrderStepDonation:
+		return decodePlutusFields(fields)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// MinswapV2OrderExpiration specifies when a Minswap V2 order expires
+type MinswapV2OrderExpiration struct {
+	// This allows the type to be used with cbor.DecodeGeneric
+	cbor.StructAsArray
+	// POSIX time in milliseconds
+	ExpiredTime        int64
+	MaxCancellationTip int64
+}
+
+func (m *MinswapV2OrderExpiration) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			m.ExpiredTime,
+			m.MaxCancellationTip,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV2OrderExpiration) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &m.ExpiredTime, &m.MaxCancellationTip)
+}
+
+// MinswapV2OrderDatum represents the datum format used by the Minswap V2 order contract
+type MinswapV2OrderDatum struct {
+	Canceller            MinswapV2AuthorizationMethod
+	RefundReceiver       PlutusAddress
+	RefundReceiverDatum  MinswapV2ExtraDatum
+	SuccessReceiver      PlutusAddress
+	SuccessReceiverDatum MinswapV2ExtraDatum
+	LpAsset              PlutusAssetClass
+	Step                 MinswapV2OrderStep
+	MaxBatcherFee        int64
+	// Expiration is nil when the order does not expire
+	Expiration *MinswapV2OrderExpiration
+}
+
+func (m *MinswapV2OrderDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&m.Canceller,
+			&m.RefundReceiver,
+			&m.RefundReceiverDatum,
+			&m.SuccessReceiver,
+			&m.SuccessReceiverDatum,
+			&m.LpAsset,
+			&m.Step,
+			m.MaxBatcherFee,
+			encodePlutusMaybe(m.Expiration),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (m *MinswapV2OrderDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 9)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpExpiration cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&m.Canceller,
+		&m.RefundReceiver,
+		&m.RefundReceiverDatum,
+		&m.SuccessReceiver,
+		&m.SuccessReceiverDatum,
+		&m.LpAsset,
+		&m.Step,
+		&m.MaxBatcherFee,
+		&tmpExpiration,
+	); err != nil {
+		return err
+	}
+	expiration, err := decodePlutusMaybe[MinswapV2OrderExpiration](tmpExpiration)
+	if err != nil {
+		return err
+	}
+	m.Expiration = expiration
+	return nil
+}
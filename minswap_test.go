@@ -0,0 +1,278 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"errors"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+var testMinAsset = models.PlutusAssetClass{
+	PolicyId:  decodeHex("29d222ce763455e3d7a09a665ce554f00ac89d2e99a1a83d267170c6"),
+	AssetName: []byte("MIN"),
+}
+
+var testLovelaceAsset = models.PlutusAssetClass{
+	PolicyId:  []byte{},
+	AssetName: []byte{},
+}
+
+func TestMinswapV1PoolDatumDecodeEncode(t *testing.T) {
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.MinswapV1PoolDatum
+	}{
+		{
+			cborHex: "d8799fd8799f4040ffd8799f581c29d222ce763455e3d7a09a665ce554f00ac89d2e99a1a83d267170c6434d494eff1b0000011f71fb04cb1b0000011f71fb0450d87a80ff",
+			expectedObj: models.MinswapV1PoolDatum{
+				AssetA:         testLovelaceAsset,
+				AssetB:         testMinAsset,
+				TotalLiquidity: 1234567890123,
+				RootKLast:      1234567890000,
+			},
+		},
+		{
+			cborHex: "d8799fd8799f4040ffd8799f581c29d222ce763455e3d7a09a665ce554f00ac89d2e99a1a83d267170c6434d494eff1903e81903e7d8799fd8799fd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ffd8799f5820aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaffffffff",
+			expectedObj: models.MinswapV1PoolDatum{
+				AssetA:         testLovelaceAsset,
+				AssetB:         testMinAsset,
+				TotalLiquidity: 1000,
+				RootKLast:      999,
+				FeeSharing: &models.MinswapV1FeeSharing{
+					FeeTo:          testPlutusAddressScript,
+					FeeToDatumHash: decodeHex("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+				},
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.MinswapV1PoolDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+func TestMinswapV1PoolDatumReserves(t *testing.T) {
+	pool := models.MinswapV1PoolDatum{
+		AssetA: testLovelaceAsset,
+		AssetB: testMinAsset,
+	}
+	value := models.PlutusValue{
+		"": {"": 1000000000},
+		string(testMinAsset.PolicyId): {
+			string(testMinAsset.AssetName): 5000000,
+		},
+	}
+	reserveA, reserveB := pool.Reserves(value)
+	if reserveA != 1000000000 || reserveB != 5000000 {
+		t.Fatalf("did not get expected reserves: got %d and %d", reserveA, reserveB)
+	}
+}
+
+func TestMinswapV1OrderDatumDecodeEncode(t *testing.T) {
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.MinswapV1OrderDatum
+	}{
+		{
+			cborHex: "d8799f" + testPlutusAddressBaseHex + testPlutusAddressBaseHex + "d87a80d8799fd8799f581c29d222ce763455e3d7a09a665ce554f00ac89d2e99a1a83d267170c6434d494eff1a004c4b40ff1a001e84801a001e8480ff",
+			expectedObj: models.MinswapV1OrderDatum{
+				Sender:   testPlutusAddressBase,
+				Receiver: testPlutusAddressBase,
+				Step: models.MinswapV1OrderStep{
+					Type:         models.MinswapV1OrderStepSwapExactIn,
+					DesiredAsset: testMinAsset,
+					Amount:       5000000,
+				},
+				BatcherFee: 2000000,
+				OutputAda:  2000000,
+			},
+		},
+		{
+			cborHex: "d8799f" + testPlutusAddressBaseHex + "d8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ffd8799f5820bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbffd87c9f0a14ff1a001e84801a001e8480ff",
+			expectedObj: models.MinswapV1OrderDatum{
+				Sender:            testPlutusAddressBase,
+				Receiver:          testPlutusAddressScript,
+				ReceiverDatumHash: decodeHex("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+				Step: models.MinswapV1OrderStep{
+					Type:          models.MinswapV1OrderStepWithdraw,
+					MinimumAssetA: 10,
+					MinimumAssetB: 20,
+				},
+				BatcherFee: 2000000,
+				OutputAda:  2000000,
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.MinswapV1OrderDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+var testMinswapV2PoolStakeCredential = models.PlutusStakingCredential{
+	Credential: &models.PlutusCredential{
+		Type: models.PlutusCredentialTypeScript,
+		Hash: decodeHex("ea07b733d932129c378af627436e7cbc2ef0bf96e0036bb51b3bde6b"),
+	},
+}
+
+// The Minswap V2 fixtures below are synthetic datums built from the published validator types,
+// not captured from mainnet transactions, so they only show that decoding and encoding agree with
+// each other. They should be replaced with mainnet pool and order datums, noting the tx hash of each
+func TestMinswapV2PoolDatumDecodeEncode(t *testing.T) {
+	feeSharing := int64(1666)
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.MinswapV2PoolDatum
+	}{
+		{
+			cborHex: "d8799fd8799fd87a9f581cea07b733d932129c378af627436e7cbc2ef0bf96e0036bb51b3bde6bffffd8799f4040ffd8799f581c29d222ce763455e3d7a09a665ce554f00ac89d2e99a1a83d267170c6434d494eff1b0000000218711a001b0000001bf08eb0001b000000746a528800181e181ed87a80d87980ff",
+			expectedObj: models.MinswapV2PoolDatum{
+				PoolBatchingStakeCredential: testMinswapV2PoolStakeCredential,
+				AssetA:                      testLovelaceAsset,
+				AssetB:                      testMinAsset,
+				TotalLiquidity:              9000000000,
+				ReserveA:                    120000000000,
+				ReserveB:                    500000000000,
+				BaseFeeANumerator:           30,
+				BaseFeeBNumerator:           30,
+			},
+		},
+		{
+			cborHex: "d8799fd8799fd87a9f581cea07b733d932129c378af627436e7cbc2ef0bf96e0036bb51b3bde6bffffd8799f4040ffd8799f581c29d222ce763455e3d7a09a665ce554f00ac89d2e99a1a83d267170c6434d494eff1b0000000218711a001b0000001bf08eb0001b000000746a528800181e181ed8799f190682ffd87a80ff",
+			expectedObj: models.MinswapV2PoolDatum{
+				PoolBatchingStakeCredential: testMinswapV2PoolStakeCredential,
+				AssetA:                      testLovelaceAsset,
+				AssetB:                      testMinAsset,
+				TotalLiquidity:              9000000000,
+				ReserveA:                    120000000000,
+				ReserveB:                    500000000000,
+				BaseFeeANumerator:           30,
+				BaseFeeBNumerator:           30,
+				FeeSharingNumerator:         &feeSharing,
+				AllowDynamicFee:             true,
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.MinswapV2PoolDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+func TestMinswapV2OrderDatumDecodeEncode(t *testing.T) {
+	canceller := models.MinswapV2AuthorizationMethod{
+		Type: models.MinswapV2AuthorizationSignature,
+		Hash: decodeHex("1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5"),
+	}
+	lpAsset := models.PlutusAssetClass{
+		PolicyId:  decodeHex("f5808c2c990d86da54bfc97d89cee6efa20cd8461616359478d96b4c"),
+		AssetName: decodeHex("82e2b1fd27a7712a1a9cf750dfbea1a5778611b20e06dd6a611df7a643f8cb75"),
+	}
+	orderPrefixHex := "d8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ff" + testPlutusAddressBaseHex + "d87980" + testPlutusAddressBaseHex
+	lpAssetHex := "d8799f581cf5808c2c990d86da54bfc97d89cee6efa20cd8461616359478d96b4c582082e2b1fd27a7712a1a9cf750dfbea1a5778611b20e06dd6a611df7a643f8cb75ff"
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.MinswapV2OrderDatum
+	}{
+		{
+			// Swap exact in
+			cborHex: orderPrefixHex + "d87980" + lpAssetHex + "d8799fd87a80d8799f1a00989680ff1a0012d687d87980ff1a001e8480d87a80ff",
+			expectedObj: models.MinswapV2OrderDatum{
+				Canceller:       canceller,
+				RefundReceiver:  testPlutusAddressBase,
+				SuccessReceiver: testPlutusAddressBase,
+				LpAsset:         lpAsset,
+				Step: models.MinswapV2OrderStep{
+					Type: models.MinswapV2OrderStepSwapExactIn,
+					AToB: true,
+					SwapAmount: models.MinswapV2AmountOption{
+						Amount: 10000000,
+					},
+					MinimumReceive: 1234567,
+				},
+				MaxBatcherFee: 2000000,
+			},
+		},
+		{
+			// OCO with inline datum and expiration
+			cborHex: orderPrefixHex + "d87b9f5820ccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccff" + lpAssetHex + "d87b9fd87980d87a9f00ff1901f41864ff1a001e8480d8799fd8799f1b000001941f297c001a000186a0ffffff",
+			expectedObj: models.MinswapV2OrderDatum{
+				Canceller:       canceller,
+				RefundReceiver:  testPlutusAddressBase,
+				SuccessReceiver: testPlutusAddressBase,
+				SuccessReceiverDatum: models.MinswapV2ExtraDatum{
+					Type: models.MinswapV2ExtraDatumInline,
+					Hash: decodeHex("cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+				},
+				LpAsset: lpAsset,
+				Step: models.MinswapV2OrderStep{
+					Type: models.MinswapV2OrderStepOco,
+					SwapAmount: models.MinswapV2AmountOption{
+						All: true,
+					},
+					MinimumReceive:  500,
+					StopLossReceive: 100,
+				},
+				MaxBatcherFee: 2000000,
+				Expiration: &models.MinswapV2OrderExpiration{
+					ExpiredTime:        1735689600000,
+					MaxCancellationTip: 100000,
+				},
+			},
+		},
+		{
+			// Deposit
+			cborHex: orderPrefixHex + "d87980" + lpAssetHex + "d87d9fd87a9f0000ff1903e8d87a80ff1a001e8480d87a80ff",
+			expectedObj: models.MinswapV2OrderDatum{
+				Canceller:       canceller,
+				RefundReceiver:  testPlutusAddressBase,
+				SuccessReceiver: testPlutusAddressBase,
+				LpAsset:         lpAsset,
+				Step: models.MinswapV2OrderStep{
+					Type: models.MinswapV2OrderStepDeposit,
+					DepositAmount: models.MinswapV2DepositAmountOption{
+						All: true,
+					},
+					MinimumLp: 1000,
+					Killable:  true,
+				},
+				MaxBatcherFee: 2000000,
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.MinswapV2OrderDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+func TestMinswapV2OrderStepUnexpectedConstructor(t *testing.T) {
+	// Constructor 9 isn't a valid order step
+	_, err := models.DecodeHex[models.MinswapV2OrderStep]("d905029f00ff")
+	if !errors.Is(err, models.ErrUnexpectedConstructor) {
+		t.Fatalf("did not get expected error: %v", err)
+	}
+}
+
+func TestMinswapV2OrderExpirationMalformedGeneralConstructor(t *testing.T) {
+	for _, cborHex := range []string{"d86580", "d8659f00ff", "d8799fd865801a00361206ffff"} {
+		if _, err := models.DecodeHex[models.MinswapV2OrderExpiration](cborHex); err == nil {
+			t.Fatalf("did not get expected error decoding %s", cborHex)
+		}
+	}
+}
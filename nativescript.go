@@ -0,0 +1,274 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"golang.org/x/crypto/blake2b"
+)
+
+// NativeScriptType identifies the kind of a native (simple) script. The values match
+// the "type" field used in the cardano-cli JSON format
+type NativeScriptType string
+
+const (
+	NativeScriptTypeSig     NativeScriptType = "sig"
+	NativeScriptTypeAll     NativeScriptType = "all"
+	NativeScriptTypeAny     NativeScriptType = "any"
+	NativeScriptTypeAtLeast NativeScriptType = "atLeast"
+	NativeScriptTypeBefore  NativeScriptType = "before"
+	NativeScriptTypeAfter   NativeScriptType = "after"
+)
+
+// CBOR type IDs for native scripts, as defined in the ledger CDDL
+const (
+	nativeScriptCborSig              = 0
+	nativeScriptCborAll              = 1
+	nativeScriptCborAny              = 2
+	nativeScriptCborNofK             = 3
+	nativeScriptCborInvalidBefore    = 4
+	nativeScriptCborInvalidHereafter = 5
+)
+
+// Size of the key hash in a "sig" native script
+const nativeScriptKeyHashSize = 28
+
+// NativeScript represents a native (simple) script, such as those used for minting policies.
+// Only the fields relevant to the script type are used
+type NativeScript struct {
+	Type     NativeScriptType
+	KeyHash  []byte
+	Required uint
+	Slot     uint64
+	Scripts  []NativeScript
+}
+
+// nativeScriptJson is the cardano-cli JSON representation of a native script
+type nativeScriptJson struct {
+	Type     NativeScriptType `json:"type"`
+	KeyHash  string           `json:"keyHash,omitempty"`
+	Required *uint            `json:"required,omitempty"`
+	Slot     *uint64          `json:"slot,omitempty"`
+	Scripts  *[]NativeScript  `json:"scripts,omitempty"`
+}
+
+func (n NativeScript) MarshalJSON() ([]byte, error) {
+	tmp := nativeScriptJson{
+		Type: n.Type,
+	}
+	switch n.Type {
+	case NativeScriptTypeSig:
+		if err := checkNativeScriptKeyHash(n.KeyHash); err != nil {
+			return nil, err
+		}
+		tmp.KeyHash = hex.EncodeToString(n.KeyHash)
+	case NativeScriptTypeAll, NativeScriptTypeAny:
+		tmp.Scripts = n.scriptsOrEmpty()
+	case NativeScriptTypeAtLeast:
+		tmp.Required = &n.Required
+		tmp.Scripts = n.scriptsOrEmpty()
+	case NativeScriptTypeBefore, NativeScriptTypeAfter:
+		tmp.Slot = &n.Slot
+	default:
+		return nil, fmt.Errorf("unknown native script type: %s", n.Type)
+	}
+	return json.Marshal(tmp)
+}
+
+func (n *NativeScript) UnmarshalJSON(data []byte) error {
+	var tmp nativeScriptJson
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	*n = NativeScript{
+		Type: tmp.Type,
+	}
+	switch tmp.Type {
+	case NativeScriptTypeSig:
+		keyHash, err := hex.DecodeString(tmp.KeyHash)
+		if err != nil {
+			return fmt.Errorf("invalid native script key hash: %w: %w", ErrNotHex, err)
+		}
+		if err := checkNativeScriptKeyHash(keyHash); err != nil {
+			return err
+		}
+		n.KeyHash = keyHash
+	case NativeScriptTypeAll, NativeScriptTypeAny:
+		if tmp.Scripts != nil {
+			n.Scripts = *tmp.Scripts
+		}
+	case NativeScriptTypeAtLeast:
+		if tmp.Required == nil {
+			return errors.New(`missing "required" field in atLeast native script`)
+		}
+		n.Required = *tmp.Required
+		if tmp.Scripts != nil {
+			n.Scripts = *tmp.Scripts
+		}
+	case NativeScriptTypeBefore, NativeScriptTypeAfter:
+		if tmp.Slot == nil {
+			return fmt.Errorf(`missing "slot" field in %s native script`, tmp.Type)
+		}
+		n.Slot = *tmp.Slot
+	default:
+		return fmt.Errorf("unknown native script type: %s", tmp.Type)
+	}
+	return nil
+}
+
+func (n *NativeScript) MarshalCBOR() ([]byte, error) {
+	var tmp []any
+	switch n.Type {
+	case NativeScriptTypeSig:
+		if err := checkNativeScriptKeyHash(n.KeyHash); err != nil {
+			return nil, err
+		}
+		tmp = []any{nativeScriptCborSig, n.KeyHash}
+	case NativeScriptTypeAll:
+		tmp = []any{nativeScriptCborAll, n.cborScripts()}
+	case NativeScriptTypeAny:
+		tmp = []any{nativeScriptCborAny, n.cborScripts()}
+	case NativeScriptTypeAtLeast:
+		tmp = []any{nativeScriptCborNofK, n.Required, n.cborScripts()}
+	case NativeScriptTypeAfter:
+		tmp = []any{nativeScriptCborInvalidBefore, n.Slot}
+	case NativeScriptTypeBefore:
+		tmp = []any{nativeScriptCborInvalidHereafter, n.Slot}
+	default:
+		return nil, fmt.Errorf("unknown native script type: %s", n.Type)
+	}
+	return cbor.Encode(&tmp)
+}
+
+func (n *NativeScript) UnmarshalCBOR(cborData []byte) error {
+	// Decode as a list first, since cbor.DecodeIdFromList panics on other types
+	var tmpList []cbor.RawMessage
+	if _, err := cbor.Decode(cborData, &tmpList); err != nil {
+		return fmt.Errorf("invalid native script: %w", err)
+	}
+	if len(tmpList) == 0 {
+		return errors.New("invalid native script: empty list")
+	}
+	var id uint
+	if _, err := cbor.Decode(tmpList[0], &id); err != nil {
+		return fmt.Errorf("invalid native script type: %w", err)
+	}
+	*n = NativeScript{}
+	switch id {
+	case nativeScriptCborSig:
+		var tmp struct {
+			cbor.StructAsArray
+			Type    uint
+			KeyHash []byte
+		}
+		if _, err := cbor.Decode(cborData, &tmp); err != nil {
+			return err
+		}
+		if err := checkNativeScriptKeyHash(tmp.KeyHash); err != nil {
+			return err
+		}
+		n.Type = NativeScriptTypeSig
+		n.KeyHash = tmp.KeyHash
+	case nativeScriptCborAll, nativeScriptCborAny:
+		var tmp struct {
+			cbor.StructAsArray
+			Type    uint
+			Scripts []NativeScript
+		}
+		if _, err := cbor.Decode(cborData, &tmp); err != nil {
+			return err
+		}
+		n.Type = NativeScriptTypeAll
+		if id == nativeScriptCborAny {
+			n.Type = NativeScriptTypeAny
+		}
+		n.Scripts = tmp.Scripts
+	case nativeScriptCborNofK:
+		var tmp struct {
+			cbor.StructAsArray
+			Type     uint
+			Required uint
+			Scripts  []NativeScript
+		}
+		if _, err := cbor.Decode(cborData, &tmp); err != nil {
+			return err
+		}
+		n.Type = NativeScriptTypeAtLeast
+		n.Required = tmp.Required
+		n.Scripts = tmp.Scripts
+	case nativeScriptCborInvalidBefore, nativeScriptCborInvalidHereafter:
+		var tmp struct {
+			cbor.StructAsArray
+			Type uint
+			Slot uint64
+		}
+		if _, err := cbor.Decode(cborData, &tmp); err != nil {
+			return err
+		}
+		n.Type = NativeScriptTypeAfter
+		if id == nativeScriptCborInvalidHereafter {
+			n.Type = NativeScriptTypeBefore
+		}
+		n.Slot = tmp.Slot
+	default:
+		return fmt.Errorf("unknown native script type ID: %d", id)
+	}
+	return nil
+}
+
+// Hash returns the script hash (policy ID when used as a minting policy). This is the
+// blake2b-224 hash of the CBOR-encoded script prefixed by the native script tag (0x00)
+func (n *NativeScript) Hash() ([]byte, error) {
+	cborData, err := n.MarshalCBOR()
+	if err != nil {
+		return nil, err
+	}
+	hasher, err := blake2b.New(28, nil)
+	if err != nil {
+		return nil, err
+	}
+	hasher.Write([]byte{0x00})
+	hasher.Write(cborData)
+	return hasher.Sum(nil), nil
+}
+
+func checkNativeScriptKeyHash(keyHash []byte) error {
+	if len(keyHash) != nativeScriptKeyHashSize {
+		return fmt.Errorf("invalid native script key hash length: %d", len(keyHash))
+	}
+	return nil
+}
+
+// scriptsOrEmpty makes sure that "scripts" is always present in the JSON output for
+// the list script types, as cardano-cli expects
+func (n NativeScript) scriptsOrEmpty() *[]NativeScript {
+	if n.Scripts == nil {
+		return &[]NativeScript{}
+	}
+	return &n.Scripts
+}
+
+func (n NativeScript) cborScripts() []any {
+	ret := make([]any, 0, len(n.Scripts))
+	for idx := range n.Scripts {
+		ret = append(ret, &n.Scripts[idx])
+	}
+	return ret
+}
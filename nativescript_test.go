@@ -0,0 +1,130 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/stretchr/testify/require"
+)
+
+var nativeScriptTestDefs = []struct {
+	name     string
+	jsonData string
+	cborHex  string
+	hashHex  string
+}{
+	{
+		name:     "Single signature",
+		jsonData: `{"type":"sig","keyHash":"e09d36c79dec9bd1b3d9e152247701cd0bb860b5ebfd1de8abb6735a"}`,
+		cborHex:  "8200581ce09d36c79dec9bd1b3d9e152247701cd0bb860b5ebfd1de8abb6735a",
+		hashHex:  "208bdcaf2d83ae026964e23659c703a377473168a39cbdc2b0241115",
+	},
+	{
+		name:     "Nested with time lock",
+		jsonData: `{"type":"all","scripts":[{"type":"sig","keyHash":"e09d36c79dec9bd1b3d9e152247701cd0bb860b5ebfd1de8abb6735a"},{"type":"atLeast","required":1,"scripts":[{"type":"sig","keyHash":"a96da581c39549aeda81f539ac3940ac0cb53657e774ca7e68f15ed9"},{"type":"before","slot":12345678}]}]}`,
+		cborHex:  "8201828200581ce09d36c79dec9bd1b3d9e152247701cd0bb860b5ebfd1de8abb6735a830301828200581ca96da581c39549aeda81f539ac3940ac0cb53657e774ca7e68f15ed982051a00bc614e",
+		hashHex:  "73951e31cf7f9eb2041642c01a08acaa9a01562ed8ca535e69866001",
+	},
+}
+
+func TestNativeScriptJsonToCbor(t *testing.T) {
+	for _, testDef := range nativeScriptTestDefs {
+		var script models.NativeScript
+		require.NoError(t, json.Unmarshal([]byte(testDef.jsonData), &script), testDef.name)
+		cborData, err := cbor.Encode(&script)
+		require.NoError(t, err, testDef.name)
+		require.Equal(t, testDef.cborHex, hex.EncodeToString(cborData), testDef.name)
+		hash, err := script.Hash()
+		require.NoError(t, err, testDef.name)
+		require.Equal(t, testDef.hashHex, hex.EncodeToString(hash), testDef.name)
+	}
+}
+
+func TestNativeScriptCborToJson(t *testing.T) {
+	for _, testDef := range nativeScriptTestDefs {
+		cborData, err := hex.DecodeString(testDef.cborHex)
+		require.NoError(t, err, testDef.name)
+		var script models.NativeScript
+		_, err = cbor.Decode(cborData, &script)
+		require.NoError(t, err, testDef.name)
+		jsonData, err := json.Marshal(&script)
+		require.NoError(t, err, testDef.name)
+		require.JSONEq(t, testDef.jsonData, string(jsonData), testDef.name)
+	}
+}
+
+func TestNativeScriptEmptyScriptsJson(t *testing.T) {
+	script := models.NativeScript{Type: models.NativeScriptTypeAny}
+	jsonData, err := json.Marshal(script)
+	require.NoError(t, err)
+	require.Equal(t, `{"type":"any","scripts":[]}`, string(jsonData))
+}
+
+func TestNativeScriptInvalidJson(t *testing.T) {
+	var script models.NativeScript
+	require.Error(t, json.Unmarshal([]byte(`{"type":"foo"}`), &script))
+	require.Error(t, json.Unmarshal([]byte(`{"type":"after"}`), &script))
+	require.Error(t, json.Unmarshal([]byte(`{"type":"atLeast","scripts":[]}`), &script))
+	require.Error(t, json.Unmarshal([]byte(`{"type":"sig","keyHash":"zz"}`), &script))
+	require.Error(t, json.Unmarshal([]byte(`{"type":"sig","keyHash":"abcd"}`), &script))
+}
+
+func TestNativeScriptInvalidCbor(t *testing.T) {
+	testDefs := []string{
+		// Plutus constructors instead of a list
+		"d8798100",
+		"d87981d87980",
+		// Empty list
+		"80",
+		// Key hash that isn't a byte string
+		"8200d87980",
+		// Key hash with the wrong length
+		"820042abcd",
+		// Unknown type ID
+		"8206",
+	}
+	for _, testDef := range testDefs {
+		_, err := models.DecodeHex[models.NativeScript](testDef)
+		require.Error(t, err, testDef)
+	}
+}
+
+func TestNativeScriptInvalidKeyHashEncode(t *testing.T) {
+	testDefs := []models.NativeScript{
+		{Type: models.NativeScriptTypeSig, KeyHash: []byte{0xab, 0xcd}},
+		{Type: models.NativeScriptTypeSig},
+		// Nested scripts are checked as well
+		{
+			Type: models.NativeScriptTypeAll,
+			Scripts: []models.NativeScript{
+				{Type: models.NativeScriptTypeSig, KeyHash: make([]byte, 29)},
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		_, err := cbor.Encode(&testDef)
+		require.Error(t, err)
+		_, err = json.Marshal(testDef)
+		require.Error(t, err)
+		_, err = testDef.Hash()
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,356 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// NebulaTradeDatum represents the datum format used by the Nebula marketplace contract, which is
+// either a listing or a bid. Exactly one of Listing and Bid is set
+type NebulaTradeDatum struct {
+	Listing *NebulaListingDetails
+	Bid     *NebulaBidDetails
+}
+
+func (n *NebulaTradeDatum) MarshalCBOR() ([]byte, error) {
+	var tmp cbor.Constructor
+	switch {
+	case n.Listing != nil:
+		tmp = cbor.NewConstructor(0, cbor.IndefLengthList{n.Listing})
+	case n.Bid != nil:
+		tmp = cbor.NewConstructor(1, cbor.IndefLengthList{n.Bid})
+	default:
+		return nil, fmt.Errorf("trade datum has neither a listing nor a bid")
+	}
+	return cbor.Encode(&tmp)
+}
+
+func (n *NebulaTradeDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 1)
+	if err != nil {
+		return err
+	}
+	*n = NebulaTradeDatum{}
+	switch constr {
+	case 0:
+		var tmp NebulaListingDetails
+		if _, err := cbor.Decode(fields[0], &tmp); err != nil {
+			return err
+		}
+		n.Listing = &tmp
+	case 1:
+		var tmp NebulaBidDetails
+		if _, err := cbor.Decode(fields[0], &tmp); err != nil {
+			return err
+		}
+		n.Bid = &tmp
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return nil
+}
+
+// NebulaListingDetails represents a Nebula listing
+type NebulaListingDetails struct {
+	Owner             PlutusCredential
+	RequestedLovelace int64
+	// PrivateListing is the only address that may buy the listing, or nil for a public listing
+	PrivateListing *PlutusAddress
+}
+
+func (n *NebulaListingDetails) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&n.Owner,
+			n.RequestedLovelace,
+			encodePlutusMaybe(n.PrivateListing),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (n *NebulaListingDetails) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 3)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpPrivateListing cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&n.Owner,
+		&n.RequestedLovelace,
+		&tmpPrivateListing,
+	); err != nil {
+		return err
+	}
+	privateListing, err := decodePlutusMaybe[PlutusAddress](tmpPrivateListing)
+	if err != nil {
+		return err
+	}
+	n.PrivateListing = privateListing
+	return nil
+}
+
+// NebulaBidDetails represents a Nebula bid
+type NebulaBidDetails struct {
+	Owner           PlutusCredential
+	RequestedOption NebulaBidOption
+}
+
+func (n *NebulaBidDetails) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&n.Owner,
+			&n.RequestedOption,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (n *NebulaBidDetails) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &n.Owner, &n.RequestedOption)
+}
+
+// NebulaBidOptionType identifies what a Nebula bid is for
+type NebulaBidOptionType uint
+
+const (
+	NebulaBidOptionSpecificValue                   NebulaBidOptionType = 0
+	NebulaBidOptionSpecificPolicyIdWithConstraints NebulaBidOptionType = 1
+)
+
+// NebulaBidOption specifies the assets requested by a Nebula bid. Only the fields relevant to
+// the type are used
+type NebulaBidOption struct {
+	Type NebulaBidOptionType
+	// Used by SpecificValue
+	Value PlutusValue
+	// Used by SpecificPolicyIdWithConstraints. An empty list of asset names allows any asset
+	// under the policy
+	PolicyId   []byte
+	AssetNames [][]byte
+	// Constraints is nil when the bid has no additional constraints
+	Constraints cbor.RawMessage
+}
+
+func (n *NebulaBidOption) MarshalCBOR() ([]byte, error) {
+	var fields cbor.IndefLengthList
+	switch n.Type {
+	case NebulaBidOptionSpecificValue:
+		fields = cbor.IndefLengthList{&n.Value}
+	case NebulaBidOptionSpecificPolicyIdWithConstraints:
+		var assetNames any = []any{}
+		if len(n.AssetNames) > 0 {
+			tmpList := make(cbor.IndefLengthList, 0, len(n.AssetNames))
+			for _, assetName := range n.AssetNames {
+				tmpList = append(tmpList, assetName)
+			}
+			assetNames = tmpList
+		}
+		var constraints *cbor.RawMessage
+		if n.Constraints != nil {
+			constraints = &n.Constraints
+		}
+		fields = cbor.IndefLengthList{
+			n.PolicyId,
+			assetNames,
+			encodePlutusMaybe(constraints),
+		}
+	default:
+		return nil, fmt.Errorf("unknown bid option type: %d", n.Type)
+	}
+	tmp := cbor.NewConstructor(uint(n.Type), fields)
+	return cbor.Encode(&tmp)
+}
+
+func (n *NebulaBidOption) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*n = NebulaBidOption{
+		Type: NebulaBidOptionType(constr),
+	}
+	switch n.Type {
+	case NebulaBidOptionSpecificValue:
+		return decodePlutusFields(fields, &n.Value)
+	case NebulaBidOptionSpecificPolicyIdWithConstraints:
+		var tmpConstraints cbor.RawMessage
+		if err := decodePlutusFields(
+			fields,
+			&n.PolicyId,
+			&n.AssetNames,
+			&tmpConstraints,
+		); err != nil {
+			return err
+		}
+		constraints, err := decodePlutusMaybe[cbor.RawMessage](tmpConstraints)
+		if err != nil {
+			return err
+		}
+		if constraints != nil {
+			n.Constraints = *constraints
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// Version of the CIP-102 royalty datum format
+const nebulaRoyaltyInfoVersion = 1
+
+func (n NebulaRoyaltyInfo) modelVersion() int {
+	return int(n.Version)
+}
+
+// NebulaRoyaltyInfo represents the CIP-102 royalty datum that Nebula reads from the collection's
+// royalty token
+type NebulaRoyaltyInfo struct {
+	Recipients []NebulaRoyaltyRecipient
+	Version    int64
+	Extra      cbor.RawMessage
+}
+
+func (n *NebulaRoyaltyInfo) MarshalCBOR() ([]byte, error) {
+	var recipients any = []any{}
+	if len(n.Recipients) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(n.Recipients))
+		for idx := range n.Recipients {
+			tmpList = append(tmpList, &n.Recipients[idx])
+		}
+		recipients = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			recipients,
+			n.Version,
+			n.Extra,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (n *NebulaRoyaltyInfo) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 3)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	if err := decodePlutusFields(fields, &n.Recipients, &n.Version, &n.Extra); err != nil {
+		return err
+	}
+	if n.Version != nebulaRoyaltyInfoVersion {
+		return fmt.Errorf("%w: %d", ErrInvalidVersion, n.Version)
+	}
+	return nil
+}
+
+// NebulaRoyaltyRecipient represents a single royalty recipient
+type NebulaRoyaltyRecipient struct {
+	Address PlutusAddress
+	// Fee is encoded as 10 divided by the royalty fraction (e.g. 1.6% is 625), per CIP-102
+	Fee int64
+	// Lovelace bounds on the royalty amount, if specified
+	MinFee *int64
+	MaxFee *int64
+}
+
+func (n *NebulaRoyaltyRecipient) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&n.Address,
+			n.Fee,
+			encodePlutusMaybe(n.MinFee),
+			encodePlutusMaybe(n.MaxFee),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (n *NebulaRoyaltyRecipient) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 4)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpMinFee, tmpMaxFee cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&n.Address,
+		&n.Fee,
+		&tmpMinFee,
+		&tmpMaxFee,
+	); err != nil {
+		return err
+	}
+	minFee, err := decodePlutusMaybe[int64](tmpMinFee)
+	if err != nil {
+		return err
+	}
+	maxFee, err := decodePlutusMaybe[int64](tmpMaxFee)
+	if err != nil {
+		return err
+	}
+	n.MinFee = minFee
+	n.MaxFee = maxFee
+	return nil
+}
+
+// Rate returns the royalty as a fraction of the sale price. It returns nil for a zero fee
+func (n NebulaRoyaltyRecipient) Rate() *big.Rat {
+	if n.Fee == 0 {
+		return nil
+	}
+	return big.NewRat(10, n.Fee)
+}
+
+// RoyaltyAmount returns the lovelace owed to the recipient for a sale at the specified price,
+// taking the minimum and maximum fee into account
+func (n NebulaRoyaltyRecipient) RoyaltyAmount(price int64) int64 {
+	var ret int64
+	if n.Fee != 0 {
+		ret = price * 10 / n.Fee
+	}
+	if n.MinFee != nil && ret < *n.MinFee {
+		ret = *n.MinFee
+	}
+	if n.MaxFee != nil && ret > *n.MaxFee {
+		ret = *n.MaxFee
+	}
+	return ret
+}
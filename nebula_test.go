@@ -0,0 +1,155 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"math/big"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+var testNebulaOwner = models.PlutusCredential{
+	Type: models.PlutusCredentialTypePubKey,
+	Hash: decodeHex("1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5"),
+}
+
+func TestNebulaTradeDatumDecodeEncode(t *testing.T) {
+	policyId := decodeHex("4523c5e21d409b81c95b45b0aea275b8ea1406e6cafea5583b9f8a5f")
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.NebulaTradeDatum
+	}{
+		{
+			// Public listing
+			cborHex: "d8799fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ff1a047868c0d87a80ffff",
+			expectedObj: models.NebulaTradeDatum{
+				Listing: &models.NebulaListingDetails{
+					Owner:             testNebulaOwner,
+					RequestedLovelace: 75000000,
+				},
+			},
+		},
+		{
+			// Private listing
+			cborHex: "d8799fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ff1a047868c0d8799fd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ffffffff",
+			expectedObj: models.NebulaTradeDatum{
+				Listing: &models.NebulaListingDetails{
+					Owner:             testNebulaOwner,
+					RequestedLovelace: 75000000,
+					PrivateListing:    &testPlutusAddressScript,
+				},
+			},
+		},
+		{
+			// Bid on a specific asset
+			cborHex: "d87a9fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fa1581c4523c5e21d409b81c95b45b0aea275b8ea1406e6cafea5583b9f8a5fa146506978656c3101ffffff",
+			expectedObj: models.NebulaTradeDatum{
+				Bid: &models.NebulaBidDetails{
+					Owner: testNebulaOwner,
+					RequestedOption: models.NebulaBidOption{
+						Type: models.NebulaBidOptionSpecificValue,
+						Value: models.PlutusValue{
+							string(policyId): {"Pixel1": 1},
+						},
+					},
+				},
+			},
+		},
+		{
+			// Collection bid
+			cborHex: "d87a9fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd87a9f581c4523c5e21d409b81c95b45b0aea275b8ea1406e6cafea5583b9f8a5f80d87a80ffffff",
+			expectedObj: models.NebulaTradeDatum{
+				Bid: &models.NebulaBidDetails{
+					Owner: testNebulaOwner,
+					RequestedOption: models.NebulaBidOption{
+						Type:       models.NebulaBidOptionSpecificPolicyIdWithConstraints,
+						PolicyId:   policyId,
+						AssetNames: [][]byte{},
+					},
+				},
+			},
+		},
+		{
+			// Bid on a set of assets with constraints
+			cborHex: "d87a9fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd87a9f581c4523c5e21d409b81c95b45b0aea275b8ea1406e6cafea5583b9f8a5f9f46506978656c3146506978656c32ffd8799fd8799f05ffffffffff",
+			expectedObj: models.NebulaTradeDatum{
+				Bid: &models.NebulaBidDetails{
+					Owner: testNebulaOwner,
+					RequestedOption: models.NebulaBidOption{
+						Type:        models.NebulaBidOptionSpecificPolicyIdWithConstraints,
+						PolicyId:    policyId,
+						AssetNames:  [][]byte{[]byte("Pixel1"), []byte("Pixel2")},
+						Constraints: cbor.RawMessage(decodeHex("d8799f05ff")),
+					},
+				},
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.NebulaTradeDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+func TestNebulaRoyaltyInfoDecodeEncode(t *testing.T) {
+	cborHex := "d8799f9fd8799fd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffff190271d8799f1a000f4240ffd87a80ffd8799fd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ff1864d87a80d8799f1a004c4b40ffffff01d87980ff"
+	expectedObj := models.NebulaRoyaltyInfo{
+		Recipients: []models.NebulaRoyaltyRecipient{
+			{
+				Address: testPlutusAddressBase,
+				Fee:     625,
+				MinFee:  int64Ptr(1000000),
+			},
+			{
+				Address: testPlutusAddressScript,
+				Fee:     100,
+				MaxFee:  int64Ptr(5000000),
+			},
+		},
+		Version: 1,
+		Extra:   cbor.RawMessage(decodeHex("d87980")),
+	}
+	var testObj models.NebulaRoyaltyInfo
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+	if testObj.Recipients[0].Rate().Cmp(big.NewRat(16, 1000)) != 0 {
+		t.Fatalf("did not get expected royalty rate: got %s", testObj.Recipients[0].Rate())
+	}
+	testRoyaltyDefs := []struct {
+		recipient int
+		price     int64
+		expected  int64
+	}{
+		{recipient: 0, price: 100000000, expected: 1600000},
+		// Minimum fee applies
+		{recipient: 0, price: 10000000, expected: 1000000},
+		// Maximum fee applies
+		{recipient: 1, price: 100000000, expected: 5000000},
+		{recipient: 1, price: 20000000, expected: 2000000},
+	}
+	for _, testRoyaltyDef := range testRoyaltyDefs {
+		amount := testObj.Recipients[testRoyaltyDef.recipient].RoyaltyAmount(testRoyaltyDef.price)
+		if amount != testRoyaltyDef.expected {
+			t.Fatalf(
+				"did not get expected royalty amount for price %d: got %d, wanted %d",
+				testRoyaltyDef.price,
+				amount,
+				testRoyaltyDef.expected,
+			)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// PriceFeed is implemented by oracle datum models that publish a price for an asset pair
+type PriceFeed interface {
+	// Pair returns the asset pair that the price is quoted for
+	Pair() PriceFeedPair
+	// Price returns the price of one unit of the base asset in the quote asset
+	Price() *big.Rat
+	// Timestamp returns the time that the price was published
+	Timestamp() time.Time
+	// Expiry returns the time after which the price should no longer be used
+	Expiry() time.Time
+}
+
+// PriceFeedPair identifies the assets that a price feed is quoted for
+type PriceFeedPair struct {
+	Base  string
+	Quote string
+}
+
+func (p PriceFeedPair) String() string {
+	return p.Base + "/" + p.Quote
+}
+
+// Keys used in the Charli3 generic price data map
+const (
+	charli3PriceDataKeyPrice     = 0
+	charli3PriceDataKeyTimestamp = 1
+	charli3PriceDataKeyExpiry    = 2
+	charli3PriceDataKeyPrecision = 3
+)
+
+// Constructor index for the price data variant of the Charli3 oracle datum
+const charli3PriceDataConstructor = 2
+
+// Precision used when a Charli3 datum doesn't specify one
+const charli3DefaultPrecision = 6
+
+// Maximum precision accepted for a Charli3 datum. The precision comes from the datum and is used
+// as an exponent when calculating the price, so it needs to be bounded
+const charli3MaxPrecision = 38
+
+// Charli3OracleDatum represents the datum format used by Charli3 oracle feeds (the
+// "common oracle datum" generic price data). The datum does not include the asset
+// pair, which is instead determined by the feed address, so FeedPair should be
+// populated by the caller
+type Charli3OracleDatum struct {
+	PriceValue int64
+	// Timestamps are in POSIX milliseconds
+	CreatedAt int64
+	ExpiresAt int64
+	// Number of decimal places in PriceValue, if present in the datum. This must be between 0 and 38
+	Precision *int64
+	FeedPair  PriceFeedPair
+}
+
+func (c *Charli3OracleDatum) MarshalCBOR() ([]byte, error) {
+	priceMap := map[uint]any{
+		charli3PriceDataKeyPrice:     c.PriceValue,
+		charli3PriceDataKeyTimestamp: c.CreatedAt,
+		charli3PriceDataKeyExpiry:    c.ExpiresAt,
+	}
+	if c.Precision != nil {
+		if err := validateCharli3Precision(*c.Precision); err != nil {
+			return nil, err
+		}
+		priceMap[charli3PriceDataKeyPrecision] = *c.Precision
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			cbor.NewConstructor(
+				charli3PriceDataConstructor,
+				cbor.IndefLengthList{priceMap},
+			),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (c *Charli3OracleDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	if len(fields) != 1 {
+		return fmt.Errorf("%w: got %d, expected 1", ErrUnexpectedFieldCount, len(fields))
+	}
+	priceConstr, priceFields, err := decodePlutusConstr(fields[0], -1)
+	if err != nil {
+		return err
+	}
+	if priceConstr != charli3PriceDataConstructor {
+		return fmt.Errorf("%w: price data %d", ErrUnexpectedConstructor, priceConstr)
+	}
+	var priceMap map[uint]cbor.RawMessage
+	if err := decodePlutusFields(priceFields, &priceMap); err != nil {
+		return err
+	}
+	// Other keys may be present in the map, so we only decode the ones we know about
+	tmpValues := map[uint]int64{}
+	for _, key := range []uint{
+		charli3PriceDataKeyPrice,
+		charli3PriceDataKeyTimestamp,
+		charli3PriceDataKeyExpiry,
+		charli3PriceDataKeyPrecision,
+	} {
+		rawValue, ok := priceMap[key]
+		if !ok {
+			continue
+		}
+		var tmpValue int64
+		if _, err := cbor.Decode(rawValue, &tmpValue); err != nil {
+			return err
+		}
+		tmpValues[key] = tmpValue
+	}
+	price, ok := tmpValues[charli3PriceDataKeyPrice]
+	if !ok {
+		return errors.New("missing price in oracle datum")
+	}
+	c.PriceValue = price
+	c.CreatedAt = tmpValues[charli3PriceDataKeyTimestamp]
+	c.ExpiresAt = tmpValues[charli3PriceDataKeyExpiry]
+	c.Precision = nil
+	if precision, ok := tmpValues[charli3PriceDataKeyPrecision]; ok {
+		if err := validateCharli3Precision(precision); err != nil {
+			return err
+		}
+		c.Precision = &precision
+	}
+	return nil
+}
+
+func (c Charli3OracleDatum) Pair() PriceFeedPair {
+	return c.FeedPair
+}
+
+// Price returns the price using the precision from the datum. It returns nil if the precision is
+// outside of the supported range, which is rejected when decoding but can be set in Go
+func (c Charli3OracleDatum) Price() *big.Rat {
+	precision := int64(charli3DefaultPrecision)
+	if c.Precision != nil {
+		precision = *c.Precision
+	}
+	if validateCharli3Precision(precision) != nil {
+		return nil
+	}
+	denom := new(big.Int).Exp(big.NewInt(10), big.NewInt(precision), nil)
+	return new(big.Rat).SetFrac(big.NewInt(c.PriceValue), denom)
+}
+
+func (c Charli3OracleDatum) Timestamp() time.Time {
+	return time.UnixMilli(c.CreatedAt)
+}
+
+func (c Charli3OracleDatum) Expiry() time.Time {
+	return time.UnixMilli(c.ExpiresAt)
+}
+
+func validateCharli3Precision(precision int64) error {
+	if precision < 0 || precision > charli3MaxPrecision {
+		return fmt.Errorf(
+			"oracle datum precision out of range: %d (must be between 0 and %d)",
+			precision,
+			charli3MaxPrecision,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+var charli3OracleTestDefs = []struct {
+	cborHex       string
+	expectedObj   models.Charli3OracleDatum
+	expectedPrice *big.Rat
+}{
+	{
+		cborHex: "d8799fd87b9fa3001a00064ab9011b000001941f297c00021b000001941f606a80ffff",
+		expectedObj: models.Charli3OracleDatum{
+			PriceValue: 412345,
+			CreatedAt:  1735689600000,
+			ExpiresAt:  1735693200000,
+		},
+		expectedPrice: big.NewRat(412345, 1000000),
+	},
+	{
+		cborHex: "d8799fd87b9fa4001a00064ab9011b000001941f297c00021b000001941f606a800304ffff",
+		expectedObj: models.Charli3OracleDatum{
+			PriceValue: 412345,
+			CreatedAt:  1735689600000,
+			ExpiresAt:  1735693200000,
+			Precision:  int64Ptr(4),
+		},
+		expectedPrice: big.NewRat(412345, 10000),
+	},
+}
+
+func TestCharli3OracleDatumDecodeEncode(t *testing.T) {
+	for _, testDef := range charli3OracleTestDefs {
+		testDatumBytes, err := hex.DecodeString(testDef.cborHex)
+		if err != nil {
+			t.Fatalf("unexpected error decoding test datum hex: %s", err)
+		}
+		// Decode CBOR into object
+		var testObj models.Charli3OracleDatum
+		if _, err := cbor.Decode(testDatumBytes, &testObj); err != nil {
+			t.Fatalf("unexpected error decoding test datum CBOR: %s", err)
+		}
+		if !reflect.DeepEqual(testObj, testDef.expectedObj) {
+			t.Fatalf(
+				"CBOR did not decode to expected object\n  got: %#v\n  wanted: %#v",
+				testObj,
+				testDef.expectedObj,
+			)
+		}
+		// Re-encode object
+		cborData, err := cbor.Encode(&testObj)
+		if err != nil {
+			t.Fatalf("unexpected error encoding test datum: %s", err)
+		}
+		if hex.EncodeToString(cborData) != testDef.cborHex {
+			t.Fatalf(
+				"object did not encode to expected CBOR\n  got: %x\n  wanted: %s",
+				cborData,
+				testDef.cborHex,
+			)
+		}
+		// Check PriceFeed interface
+		var feed models.PriceFeed = testObj
+		if feed.Price().Cmp(testDef.expectedPrice) != 0 {
+			t.Fatalf(
+				"did not get expected price: got %s, wanted %s",
+				feed.Price().String(),
+				testDef.expectedPrice.String(),
+			)
+		}
+		if !feed.Timestamp().Equal(time.UnixMilli(testDef.expectedObj.CreatedAt)) {
+			t.Fatalf("did not get expected timestamp: got %s", feed.Timestamp())
+		}
+		if !feed.Expiry().Equal(time.UnixMilli(testDef.expectedObj.ExpiresAt)) {
+			t.Fatalf("did not get expected expiry: got %s", feed.Expiry())
+		}
+	}
+}
+
+func TestCharli3OracleDatumInvalid(t *testing.T) {
+	testDefs := []struct {
+		cborHex     string
+		expectedErr error
+	}{
+		// Precision of 39
+		{cborHex: "d8799fd87b9fa4001a00064ab9011b000001941f297c00021b000001941f606a80031827ffff"},
+		// Negative precision
+		{cborHex: "d8799fd87b9fa4001a00064ab9011b000001941f297c00021b000001941f606a800320ffff"},
+		// Huge precision
+		{cborHex: "d8799fd87b9fa4001a00064ab9011b000001941f297c00021b000001941f606a80031b7fffffffffffffffffff"},
+		// Unexpected price data constructor
+		{
+			cborHex:     "d8799fd87a9fa3001a00064ab9011b000001941f297c00021b000001941f606a80ffff",
+			expectedErr: models.ErrUnexpectedConstructor,
+		},
+		// Malformed general constructor
+		{cborHex: "d8799fd86580ff"},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.Charli3OracleDatum
+		_, err := cbor.Decode(decodeHex(testDef.cborHex), &testObj)
+		if err == nil {
+			t.Fatalf("did not get expected error decoding %s", testDef.cborHex)
+		}
+		if testDef.expectedErr != nil && !errors.Is(err, testDef.expectedErr) {
+			t.Fatalf("did not get expected error: got %s, wanted %s", err, testDef.expectedErr)
+		}
+	}
+	// Out of range precision set in Go
+	testObj := models.Charli3OracleDatum{
+		PriceValue: 412345,
+		Precision:  int64Ptr(1 << 40),
+	}
+	if _, err := cbor.Encode(&testObj); err == nil {
+		t.Fatalf("did not get expected error encoding datum with invalid precision")
+	}
+	if testObj.Price() != nil {
+		t.Fatalf("did not get expected nil price for invalid precision")
+	}
+}
@@ -0,0 +1,588 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/blinklabs-io/gouroboros/bech32"
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// This file contains common Plutus ledger API types which are used as building blocks
+// by the various smart contract datum models
+
+// CBOR tag used for Plutus data constructors with an index that doesn't have a dedicated tag
+const plutusConstrTagGeneral = 102
+
+// Size of the credential hashes in an address
+const plutusAddressHashSize = 28
+
+// PlutusCredentialType identifies whether a credential is a public key hash or a script hash
+type PlutusCredentialType uint
+
+const (
+	PlutusCredentialTypePubKey PlutusCredentialType = 0
+	PlutusCredentialTypeScript PlutusCredentialType = 1
+)
+
+// PlutusCredential represents a payment or staking credential
+type PlutusCredential struct {
+	Type PlutusCredentialType
+	Hash []byte
+}
+
+func (c *PlutusCredential) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		uint(c.Type),
+		cbor.IndefLengthList{
+			c.Hash,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (c *PlutusCredential) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 1)
+	if err != nil {
+		return err
+	}
+	switch PlutusCredentialType(constr) {
+	case PlutusCredentialTypePubKey, PlutusCredentialTypeScript:
+		c.Type = PlutusCredentialType(constr)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	if _, err := cbor.Decode(fields[0], &c.Hash); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PlutusStakingCredential represents a staking credential, which is either a credential
+// (StakingHash) or a pointer to a stake registration certificate (StakingPtr)
+type PlutusStakingCredential struct {
+	Credential *PlutusCredential
+	Pointer    *PlutusStakingPointer
+}
+
+// PlutusStakingPointer identifies a stake registration certificate by its location on chain
+type PlutusStakingPointer struct {
+	// This allows the type to be used with cbor.DecodeGeneric
+	cbor.StructAsArray
+	Slot      int64
+	TxIndex   int64
+	CertIndex int64
+}
+
+func (c *PlutusStakingCredential) MarshalCBOR() ([]byte, error) {
+	var tmp cbor.Constructor
+	switch {
+	case c.Credential != nil:
+		tmp = cbor.NewConstructor(
+			0,
+			cbor.IndefLengthList{
+				c.Credential,
+			},
+		)
+	case c.Pointer != nil:
+		tmp = cbor.NewConstructor(
+			1,
+			cbor.IndefLengthList{
+				c.Pointer.Slot,
+				c.Pointer.TxIndex,
+				c.Pointer.CertIndex,
+			},
+		)
+	default:
+		return nil, fmt.Errorf("staking credential has neither a credential nor a pointer")
+	}
+	return cbor.Encode(&tmp)
+}
+
+func (c *PlutusStakingCredential) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*c = PlutusStakingCredential{}
+	switch constr {
+	case 0:
+		var tmp PlutusCredential
+		if err := decodePlutusFields(fields, &tmp); err != nil {
+			return err
+		}
+		c.Credential = &tmp
+	case 1:
+		var tmp PlutusStakingPointer
+		if err := decodePlutusFields(fields, &tmp.Slot, &tmp.TxIndex, &tmp.CertIndex); err != nil {
+			return err
+		}
+		c.Pointer = &tmp
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return nil
+}
+
+// PlutusAddress represents an address as seen by a Plutus script
+type PlutusAddress struct {
+	PaymentCredential PlutusCredential
+	// StakingCredential is nil when the address has no staking part
+	StakingCredential *PlutusStakingCredential
+}
+
+func (a *PlutusAddress) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&a.PaymentCredential,
+			encodePlutusMaybe(a.StakingCredential),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (a *PlutusAddress) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	if _, err := cbor.Decode(fields[0], &a.PaymentCredential); err != nil {
+		return err
+	}
+	stakingCredential, err := decodePlutusMaybe[PlutusStakingCredential](fields[1])
+	if err != nil {
+		return err
+	}
+	a.StakingCredential = stakingCredential
+	return nil
+}
+
+// NewPlutusAddressFromBech32 returns the Plutus representation of a bech32-encoded Shelley payment
+// address (base, pointer or enterprise)
+func NewPlutusAddressFromBech32(addr string) (PlutusAddress, error) {
+	_, data, err := bech32.DecodeNoLimit(addr)
+	if err != nil {
+		return PlutusAddress{}, err
+	}
+	addrBytes, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return PlutusAddress{}, err
+	}
+	if len(addrBytes) < 1+plutusAddressHashSize {
+		return PlutusAddress{}, fmt.Errorf("invalid address length: %d", len(addrBytes))
+	}
+	addrType := addrBytes[0] >> 4
+	if addrType > 0x07 {
+		return PlutusAddress{}, fmt.Errorf("unsupported address type: %d", addrType)
+	}
+	ret := PlutusAddress{
+		PaymentCredential: PlutusCredential{
+			Type: PlutusCredentialType(addrType & 0x01),
+			Hash: addrBytes[1 : 1+plutusAddressHashSize],
+		},
+	}
+	stakePart := addrBytes[1+plutusAddressHashSize:]
+	switch addrType >> 1 {
+	case 0, 1:
+		// Base address, with the staking credential type in the second bit
+		if len(stakePart) != plutusAddressHashSize {
+			return PlutusAddress{}, fmt.Errorf("invalid address length: %d", len(addrBytes))
+		}
+		ret.StakingCredential = &PlutusStakingCredential{
+			Credential: &PlutusCredential{
+				Type: PlutusCredentialType((addrType >> 1) & 0x01),
+				Hash: stakePart,
+			},
+		}
+	case 2:
+		// Pointer address
+		var ptrValues [3]int64
+		for idx := range ptrValues {
+			val, n := decodeAddressPointerNat(stakePart)
+			if n == 0 || val > math.MaxInt64 {
+				return PlutusAddress{}, fmt.Errorf("invalid stake pointer in address")
+			}
+			ptrValues[idx] = int64(val)
+			stakePart = stakePart[n:]
+		}
+		if len(stakePart) > 0 {
+			return PlutusAddress{}, fmt.Errorf("invalid address length: %d", len(addrBytes))
+		}
+		ret.StakingCredential = &PlutusStakingCredential{
+			Pointer: &PlutusStakingPointer{
+				Slot:      ptrValues[0],
+				TxIndex:   ptrValues[1],
+				CertIndex: ptrValues[2],
+			},
+		}
+	case 3:
+		// Enterprise address
+		if len(stakePart) > 0 {
+			return PlutusAddress{}, fmt.Errorf("invalid address length: %d", len(addrBytes))
+		}
+	}
+	return ret, nil
+}
+
+// decodeAddressPointerNat decodes a variable-length natural number from a pointer address, which
+// uses big-endian groups of 7 bits with the high bit set on all but the last byte. It returns the
+// value and the number of bytes read, or 0 bytes read if the number is invalid
+func decodeAddressPointerNat(data []byte) (uint64, int) {
+	var ret uint64
+	for idx, b := range data {
+		if ret > math.MaxUint64>>7 {
+			return 0, 0
+		}
+		ret = ret<<7 | uint64(b&0x7f)
+		if b&0x80 == 0 {
+			return ret, idx + 1
+		}
+	}
+	return 0, 0
+}
+
+// PlutusAssetClass identifies a native asset by its policy ID and asset name. ADA is
+// represented by an empty policy ID and asset name
+type PlutusAssetClass struct {
+	// This allows the type to be used with cbor.DecodeGeneric
+	cbor.StructAsArray
+	PolicyId  []byte
+	AssetName []byte
+}
+
+func (a *PlutusAssetClass) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			a.PolicyId,
+			a.AssetName,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (a *PlutusAssetClass) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &a.PolicyId, &a.AssetName)
+}
+
+// IsLovelace returns true if the asset class represents ADA
+func (a PlutusAssetClass) IsLovelace() bool {
+	return len(a.PolicyId) == 0 && len(a.AssetName) == 0
+}
+
+// PlutusValue represents a multi-asset value, keyed by policy ID and then asset name.
+// The keys are the raw bytes of the policy ID and asset name, and ADA uses an empty
+// policy ID and asset name
+type PlutusValue map[string]map[string]int64
+
+// AssetAmount returns the quantity of the specified asset in the value
+func (v PlutusValue) AssetAmount(asset PlutusAssetClass) int64 {
+	return v[string(asset.PolicyId)][string(asset.AssetName)]
+}
+
+// MarshalCBOR encodes the value as Plutus data. Policy IDs and asset names are sorted in
+// ascending byte order to match the on-chain representation
+func (v *PlutusValue) MarshalCBOR() ([]byte, error) {
+	ret := encodePlutusMapHeader(len(*v))
+	for _, policyId := range sortedPlutusMapKeys(*v) {
+		assets := (*v)[policyId]
+		keyCbor, err := cbor.Encode([]byte(policyId))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, keyCbor...)
+		ret = append(ret, encodePlutusMapHeader(len(assets))...)
+		for _, assetName := range sortedPlutusMapKeys(assets) {
+			assetCbor, err := cbor.Encode([]byte(assetName))
+			if err != nil {
+				return nil, err
+			}
+			amountCbor, err := cbor.Encode(assets[assetName])
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, assetCbor...)
+			ret = append(ret, amountCbor...)
+		}
+	}
+	return ret, nil
+}
+
+func (v *PlutusValue) UnmarshalCBOR(cborData []byte) error {
+	var tmpValue map[cbor.ByteString]map[cbor.ByteString]int64
+	if _, err := cbor.Decode(cborData, &tmpValue); err != nil {
+		return err
+	}
+	*v = make(PlutusValue, len(tmpValue))
+	for policyId, assets := range tmpValue {
+		tmpAssets := make(map[string]int64, len(assets))
+		for assetName, amount := range assets {
+			tmpAssets[string(assetName.Bytes())] = amount
+		}
+		(*v)[string(policyId.Bytes())] = tmpAssets
+	}
+	return nil
+}
+
+func sortedPlutusMapKeys[T any](m map[string]T) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	slices.Sort(ret)
+	return ret
+}
+
+// encodePlutusMapHeader returns the CBOR header for a definite-length map with the specified
+// number of entries
+func encodePlutusMapHeader(length int) []byte {
+	const cborTypeMap = 0xa0
+	switch {
+	case length < 24:
+		return []byte{cborTypeMap | byte(length)}
+	case length <= math.MaxUint8:
+		return []byte{cborTypeMap | 24, byte(length)}
+	case length <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16([]byte{cborTypeMap | 25}, uint16(length))
+	default:
+		return binary.BigEndian.AppendUint32([]byte{cborTypeMap | 26}, uint32(length))
+	}
+}
+
+// decodePlutusMap decodes a Plutus data map and returns the raw CBOR for each key and value,
+// preserving the order of the entries. This allows decoding maps with keys that can't be used
+// as Go map keys, such as constructors
+func decodePlutusMap(cborData []byte) ([][2]cbor.RawMessage, error) {
+	if len(cborData) == 0 || cborData[0]&0xe0 != 0xa0 {
+		return nil, fmt.Errorf("data is not a CBOR map")
+	}
+	// Determine the number of entries from the map header
+	indefLength := false
+	var numEntries uint64
+	offset := 1
+	switch info := cborData[0] & 0x1f; {
+	case info < 24:
+		numEntries = uint64(info)
+	case info <= 27:
+		headerLen := 1 << (info - 24)
+		if len(cborData) < 1+headerLen {
+			return nil, fmt.Errorf("truncated CBOR map header")
+		}
+		for _, b := range cborData[1 : 1+headerLen] {
+			numEntries = numEntries<<8 | uint64(b)
+		}
+		offset += headerLen
+	case info == 31:
+		indefLength = true
+	default:
+		return nil, fmt.Errorf("invalid CBOR map header: %x", cborData[0])
+	}
+	var ret [][2]cbor.RawMessage
+	for idx := uint64(0); indefLength || idx < numEntries; idx++ {
+		if indefLength && offset < len(cborData) && cborData[offset] == 0xff {
+			break
+		}
+		var entry [2]cbor.RawMessage
+		for i := range entry {
+			if offset >= len(cborData) {
+				return nil, fmt.Errorf("truncated CBOR map")
+			}
+			bytesRead, err := cbor.Decode(cborData[offset:], &entry[i])
+			if err != nil {
+				return nil, err
+			}
+			offset += bytesRead
+		}
+		ret = append(ret, entry)
+	}
+	return ret, nil
+}
+
+// encodePlutusMap encodes the provided key/value pairs as a Plutus data map, preserving the
+// order of the entries
+func encodePlutusMap(entries [][2]any) ([]byte, error) {
+	ret := encodePlutusMapHeader(len(entries))
+	for _, entry := range entries {
+		for _, item := range entry {
+			itemCbor, err := cbor.Encode(item)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, itemCbor...)
+		}
+	}
+	return ret, nil
+}
+
+// decodePlutusConstr decodes a Plutus data constructor and returns its index along with the
+// raw CBOR for each field. An error is returned if the number of fields doesn't match, unless
+// a negative number of fields is specified. The tag is parsed directly rather than using
+// cbor.Constructor, which can't handle fields containing maps with constructor keys
+func decodePlutusConstr(cborData []byte, numFields int) (uint, []cbor.RawMessage, error) {
+	var tmpTag cbor.RawTag
+	if _, err := cbor.Decode(cborData, &tmpTag); err != nil {
+		return 0, nil, err
+	}
+	var constr uint
+	fieldsCbor := []byte(tmpTag.Content)
+	switch {
+	case tmpTag.Number >= cbor.CborTagAlternative1Min && tmpTag.Number <= cbor.CborTagAlternative1Max:
+		constr = uint(tmpTag.Number - cbor.CborTagAlternative1Min)
+	case tmpTag.Number >= cbor.CborTagAlternative2Min && tmpTag.Number <= cbor.CborTagAlternative2Max:
+		constr = uint(tmpTag.Number-cbor.CborTagAlternative2Min) + 7
+	case tmpTag.Number == plutusConstrTagGeneral:
+		var tmpGeneral struct {
+			cbor.StructAsArray
+			Constructor uint
+			Fields      cbor.RawMessage
+		}
+		if _, err := cbor.Decode(fieldsCbor, &tmpGeneral); err != nil {
+			return 0, nil, err
+		}
+		constr = tmpGeneral.Constructor
+		fieldsCbor = tmpGeneral.Fields
+	default:
+		return 0, nil, fmt.Errorf("unexpected CBOR tag for constructor: %d", tmpTag.Number)
+	}
+	var fields []cbor.RawMessage
+	if _, err := cbor.Decode(fieldsCbor, &fields); err != nil {
+		return 0, nil, err
+	}
+	if numFields >= 0 && len(fields) != numFields {
+		return 0, nil, fmt.Errorf(
+			"%w for constructor %d: got %d, expected %d",
+			ErrUnexpectedFieldCount,
+			constr,
+			len(fields),
+			numFields,
+		)
+	}
+	return constr, fields, nil
+}
+
+// decodePlutusFields decodes the raw CBOR for each constructor field into the matching
+// destination. A *bool destination is decoded from the Plutus Bool representation
+func decodePlutusFields(fields []cbor.RawMessage, dests ...any) error {
+	if len(fields) != len(dests) {
+		return fmt.Errorf(
+			"%w: got %d, expected %d",
+			ErrUnexpectedFieldCount,
+			len(fields),
+			len(dests),
+		)
+	}
+	for idx, dest := range dests {
+		if tmpBool, ok := dest.(*bool); ok {
+			val, err := decodePlutusBool(fields[idx])
+			if err != nil {
+				return err
+			}
+			*tmpBool = val
+			continue
+		}
+		if _, err := cbor.Decode(fields[idx], dest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodePlutusBool decodes a Plutus Bool value (False is constructor 0, True is constructor 1)
+func decodePlutusBool(cborData []byte) (bool, error) {
+	constr, _, err := decodePlutusConstr(cborData, 0)
+	if err != nil {
+		return false, err
+	}
+	switch constr {
+	case 0:
+		return false, nil
+	case 1:
+		return true, nil
+	default:
+		return false, fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// encodePlutusBool returns a Plutus Bool value
+func encodePlutusBool(v bool) cbor.Constructor {
+	if v {
+		return cbor.NewConstructor(1, []any{})
+	}
+	return cbor.NewConstructor(0, []any{})
+}
+
+// decodePlutusMaybe decodes a Plutus Maybe value, returning nil for Nothing
+func decodePlutusMaybe[T any](cborData []byte) (*T, error) {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return nil, err
+	}
+	switch constr {
+	case 0:
+		if len(fields) != 1 {
+			return nil, fmt.Errorf("%w: got %d, expected 1", ErrUnexpectedFieldCount, len(fields))
+		}
+		var tmp T
+		if _, err := cbor.Decode(fields[0], &tmp); err != nil {
+			return nil, err
+		}
+		return &tmp, nil
+	case 1:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// encodePlutusMaybe returns a Plutus Maybe value, using Nothing for a nil value
+func encodePlutusMaybe[T any](v *T) cbor.Constructor {
+	if v == nil {
+		return cbor.NewConstructor(1, []any{})
+	}
+	return cbor.NewConstructor(0, cbor.IndefLengthList{v})
+}
+
+// Maximum length of a single bytestring chunk in Plutus data
+const plutusBytesChunkSize = 64
+
+// encodePlutusBytes returns a bytestring for use in Plutus data. Bytestrings longer than 64 bytes
+// are split into chunks using an indefinite-length bytestring, as required by the ledger
+func encodePlutusBytes(v []byte) any {
+	if len(v) <= plutusBytesChunkSize {
+		return v
+	}
+	ret := make(cbor.IndefLengthByteString, 0, (len(v)+plutusBytesChunkSize-1)/plutusBytesChunkSize)
+	for len(v) > 0 {
+		chunkLen := min(len(v), plutusBytesChunkSize)
+		ret = append(ret, v[:chunkLen])
+		v = v[chunkLen:]
+	}
+	return ret
+}
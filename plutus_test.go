@@ -0,0 +1,198 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// testDecodeEncode decodes the provided CBOR hex into dest, compares it to the expected object,
+// and then re-encodes it to make sure that it matches the original CBOR
+func testDecodeEncode(t *testing.T, cborHex string, dest any, expectedObj any) {
+	t.Helper()
+	testDatumBytes, err := hex.DecodeString(cborHex)
+	if err != nil {
+		t.Fatalf("unexpected error decoding test datum hex: %s", err)
+	}
+	// Decode CBOR into object
+	if _, err := cbor.Decode(testDatumBytes, dest); err != nil {
+		t.Fatalf("unexpected error decoding test datum CBOR: %s", err)
+	}
+	if !reflect.DeepEqual(dest, expectedObj) {
+		t.Fatalf(
+			"CBOR did not decode to expected object\n  got: %#v\n  wanted: %#v",
+			dest,
+			expectedObj,
+		)
+	}
+	// Re-encode object
+	cborData, err := cbor.Encode(dest)
+	if err != nil {
+		t.Fatalf("unexpected error encoding test datum: %s", err)
+	}
+	if hex.EncodeToString(cborData) != cborHex {
+		t.Fatalf(
+			"object did not encode to expected CBOR\n  got: %x\n  wanted: %s",
+			cborData,
+			cborHex,
+		)
+	}
+}
+
+func decodeHex(hexData string) []byte {
+	ret, err := hex.DecodeString(hexData)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+var testPlutusAddressBase = models.PlutusAddress{
+	PaymentCredential: models.PlutusCredential{
+		Type: models.PlutusCredentialTypePubKey,
+		Hash: decodeHex("1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5"),
+	},
+	StakingCredential: &models.PlutusStakingCredential{
+		Credential: &models.PlutusCredential{
+			Type: models.PlutusCredentialTypePubKey,
+			Hash: decodeHex("5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809"),
+		},
+	},
+}
+
+var testPlutusAddressScript = models.PlutusAddress{
+	PaymentCredential: models.PlutusCredential{
+		Type: models.PlutusCredentialTypeScript,
+		Hash: decodeHex("a65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3b"),
+	},
+}
+
+var testPlutusAddressBaseHex = "d8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffff"
+
+func TestNewPlutusAddressFromBech32(t *testing.T) {
+	testDefs := []struct {
+		addr        string
+		expectedObj models.PlutusAddress
+	}{
+		{
+			addr:        "addr1qydzk0zdtehhpqvj5w6vt4h8lqy352euf40x7uypj23mf327dacgry4rknzadelcpydzk0zdtehhpqvj5w6vt4h8lqyseqgujn",
+			expectedObj: testPlutusAddressBase,
+		},
+		{
+			addr:        "addr1wxn9efv2f6w82hagxqtn62ju4m293tqvw0uhmdl64ch8uwc0h43gt",
+			expectedObj: testPlutusAddressScript,
+		},
+		{
+			addr: "addr1gydzk0zdtehhpqvj5w6vt4h8lqy352euf40x7uypj23mf3vrs0eh2qcq2j2nmu",
+			expectedObj: models.PlutusAddress{
+				PaymentCredential: testPlutusAddressBase.PaymentCredential,
+				StakingCredential: &models.PlutusStakingCredential{
+					Pointer: &models.PlutusStakingPointer{
+						Slot:      6355445,
+						TxIndex:   3,
+						CertIndex: 0,
+					},
+				},
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		addr, err := models.NewPlutusAddressFromBech32(testDef.addr)
+		if err != nil {
+			t.Fatalf("unexpected error converting address %s: %s", testDef.addr, err)
+		}
+		if !reflect.DeepEqual(addr, testDef.expectedObj) {
+			t.Fatalf(
+				"address did not convert to expected object\n  got: %#v\n  wanted: %#v",
+				addr,
+				testDef.expectedObj,
+			)
+		}
+	}
+	// Stake addresses and invalid addresses are rejected
+	for _, addr := range []string{
+		"stake1uydzk0zdtehhpqvj5w6vt4h8lqy352euf40x7uypj23mf3gy3c0yt",
+		"addr1wxn9efv2f6w82hagxqtn62ju4m293tqvw0uhmdl64ch8uwc0h43gq",
+	} {
+		if _, err := models.NewPlutusAddressFromBech32(addr); err == nil {
+			t.Fatalf("did not get expected error converting address %s", addr)
+		}
+	}
+}
+
+func TestPlutusAddressDecodeEncode(t *testing.T) {
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.PlutusAddress
+	}{
+		{
+			cborHex:     testPlutusAddressBaseHex,
+			expectedObj: testPlutusAddressBase,
+		},
+		{
+			cborHex:     "d8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ff",
+			expectedObj: testPlutusAddressScript,
+		},
+		{
+			cborHex: "d8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd87a9f1a0060f9f50300ffffff",
+			expectedObj: models.PlutusAddress{
+				PaymentCredential: testPlutusAddressBase.PaymentCredential,
+				StakingCredential: &models.PlutusStakingCredential{
+					Pointer: &models.PlutusStakingPointer{
+						Slot:      6355445,
+						TxIndex:   3,
+						CertIndex: 0,
+					},
+				},
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.PlutusAddress
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+func TestPlutusDecodeMalformedGeneralConstructor(t *testing.T) {
+	// Constructors using the general form (tag 101) with missing fields, both at the top level
+	// and nested inside another constructor
+	testCborHexes := []string{
+		"d86580",
+		"d8659f00ff",
+		"d8799fd865801a00361206ffff",
+	}
+	testDests := []func() any{
+		func() any { return &models.PlutusAssetClass{} },
+		func() any { return &models.PlutusStakingCredential{} },
+		func() any { return &models.PlutusAddress{} },
+		func() any { return &models.MarloweParty{} },
+		func() any { return &models.MinswapV1OrderStep{} },
+		func() any { return &models.MinswapV1PoolDatum{} },
+	}
+	for _, cborHex := range testCborHexes {
+		for _, newDest := range testDests {
+			dest := newDest()
+			if _, err := cbor.Decode(decodeHex(cborHex), dest); err == nil {
+				t.Fatalf("did not get expected error decoding %s into %T", cborHex, dest)
+			}
+		}
+	}
+}
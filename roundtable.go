@@ -0,0 +1,75 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// RoundTableMultisigDatum represents the state of a Round Table open multisig wallet script
+type RoundTableMultisigDatum struct {
+	// Signers is the list of public key hashes allowed to sign for the wallet
+	Signers [][]byte
+	// Threshold is the number of signatures required to spend from the wallet
+	Threshold int64
+}
+
+func (r *RoundTableMultisigDatum) MarshalCBOR() ([]byte, error) {
+	var signers any = []any{}
+	if len(r.Signers) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(r.Signers))
+		for _, signer := range r.Signers {
+			tmpList = append(tmpList, signer)
+		}
+		signers = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			signers,
+			r.Threshold,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (r *RoundTableMultisigDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &r.Signers, &r.Threshold)
+}
+
+// IsSatisfiedBy returns true if the provided key hashes include enough distinct wallet signers
+// to meet the threshold
+func (r RoundTableMultisigDatum) IsSatisfiedBy(keyHashes [][]byte) bool {
+	var count int64
+	for _, signer := range r.Signers {
+		for _, keyHash := range keyHashes {
+			if bytes.Equal(signer, keyHash) {
+				count++
+				break
+			}
+		}
+	}
+	return count >= r.Threshold
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"bytes"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestRoundTableMultisigDatumDecodeEncode(t *testing.T) {
+	testCborHex := "d8799f9f581c01010101010101010101010101010101010101010101010101010101581c02020202020202020202020202020202020202020202020202020202581c03030303030303030303030303030303030303030303030303030303ff02ff"
+	expectedObj := models.RoundTableMultisigDatum{
+		Signers: [][]byte{
+			bytes.Repeat([]byte{0x01}, 28),
+			bytes.Repeat([]byte{0x02}, 28),
+			bytes.Repeat([]byte{0x03}, 28),
+		},
+		Threshold: 2,
+	}
+	var testObj models.RoundTableMultisigDatum
+	testDecodeEncode(t, testCborHex, &testObj, &expectedObj)
+	if testObj.IsSatisfiedBy([][]byte{bytes.Repeat([]byte{0x02}, 28)}) {
+		t.Fatalf("expected a single signature not to satisfy the threshold")
+	}
+	if !testObj.IsSatisfiedBy(
+		[][]byte{
+			bytes.Repeat([]byte{0x03}, 28),
+			bytes.Repeat([]byte{0x04}, 28),
+			bytes.Repeat([]byte{0x01}, 28),
+		},
+	) {
+		t.Fatalf("expected two signer signatures to satisfy the threshold")
+	}
+}
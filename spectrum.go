@@ -0,0 +1,369 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// SpectrumFeeDenominator is the denominator used with the fee numerators in Spectrum pool and
+// swap datums
+const SpectrumFeeDenominator = 1000
+
+// SpectrumPoolDatum represents the datum format used by the Spectrum constant product pool contract
+type SpectrumPoolDatum struct {
+	PoolNft PlutusAssetClass
+	AssetX  PlutusAssetClass
+	AssetY  PlutusAssetClass
+	AssetLq PlutusAssetClass
+	FeeNum  int64
+	// Policies that are allowed to change the stake delegation of the pool
+	StakeAdminPolicy [][]byte
+	// Minimum amount of liquidity that must remain in the pool
+	LqBound int64
+}
+
+func (s *SpectrumPoolDatum) MarshalCBOR() ([]byte, error) {
+	var stakeAdminPolicy any = []any{}
+	if len(s.StakeAdminPolicy) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(s.StakeAdminPolicy))
+		for _, policyId := range s.StakeAdminPolicy {
+			tmpList = append(tmpList, policyId)
+		}
+		stakeAdminPolicy = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&s.PoolNft,
+			&s.AssetX,
+			&s.AssetY,
+			&s.AssetLq,
+			s.FeeNum,
+			stakeAdminPolicy,
+			s.LqBound,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SpectrumPoolDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 7)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(
+		fields,
+		&s.PoolNft,
+		&s.AssetX,
+		&s.AssetY,
+		&s.AssetLq,
+		&s.FeeNum,
+		&s.StakeAdminPolicy,
+		&s.LqBound,
+	)
+}
+
+// Reserves returns the pool reserves of asset X and asset Y from the pool UTxO value
+func (s SpectrumPoolDatum) Reserves(value PlutusValue) (int64, int64) {
+	return value.AssetAmount(s.AssetX), value.AssetAmount(s.AssetY)
+}
+
+// SpectrumSwapDatum represents the datum format used by the Spectrum swap order contract
+type SpectrumSwapDatum struct {
+	Base    PlutusAssetClass
+	Quote   PlutusAssetClass
+	PoolNft PlutusAssetClass
+	FeeNum  int64
+	// Execution fee paid to the batcher per unit of quote asset received
+	ExFeePerTokenNum int64
+	ExFeePerTokenDen int64
+	RewardPkh        []byte
+	// StakePkh is nil when the reward address has no staking part
+	StakePkh       []byte
+	BaseAmount     int64
+	MinQuoteAmount int64
+}
+
+func (s *SpectrumSwapDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&s.Base,
+			&s.Quote,
+			&s.PoolNft,
+			s.FeeNum,
+			s.ExFeePerTokenNum,
+			s.ExFeePerTokenDen,
+			s.RewardPkh,
+			encodeSpectrumStakePkh(s.StakePkh),
+			s.BaseAmount,
+			s.MinQuoteAmount,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SpectrumSwapDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 10)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpStakePkh cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&s.Base,
+		&s.Quote,
+		&s.PoolNft,
+		&s.FeeNum,
+		&s.ExFeePerTokenNum,
+		&s.ExFeePerTokenDen,
+		&s.RewardPkh,
+		&tmpStakePkh,
+		&s.BaseAmount,
+		&s.MinQuoteAmount,
+	); err != nil {
+		return err
+	}
+	stakePkh, err := decodeSpectrumStakePkh(tmpStakePkh)
+	if err != nil {
+		return err
+	}
+	s.StakePkh = stakePkh
+	return nil
+}
+
+// SpectrumDepositDatum represents the datum format used by the Spectrum deposit order contract
+type SpectrumDepositDatum struct {
+	PoolNft   PlutusAssetClass
+	AssetX    PlutusAssetClass
+	AssetY    PlutusAssetClass
+	AssetLq   PlutusAssetClass
+	ExFee     int64
+	RewardPkh []byte
+	// StakePkh is nil when the reward address has no staking part
+	StakePkh []byte
+	// ADA included in the order to cover the min UTxO of the reward output
+	CollateralAda int64
+}
+
+func (s *SpectrumDepositDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&s.PoolNft,
+			&s.AssetX,
+			&s.AssetY,
+			&s.AssetLq,
+			s.ExFee,
+			s.RewardPkh,
+			encodeSpectrumStakePkh(s.StakePkh),
+			s.CollateralAda,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SpectrumDepositDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 8)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpStakePkh cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&s.PoolNft,
+		&s.AssetX,
+		&s.AssetY,
+		&s.AssetLq,
+		&s.ExFee,
+		&s.RewardPkh,
+		&tmpStakePkh,
+		&s.CollateralAda,
+	); err != nil {
+		return err
+	}
+	stakePkh, err := decodeSpectrumStakePkh(tmpStakePkh)
+	if err != nil {
+		return err
+	}
+	s.StakePkh = stakePkh
+	return nil
+}
+
+// SpectrumRedeemDatum represents the datum format used by the Spectrum redeem order contract
+type SpectrumRedeemDatum struct {
+	PoolNft   PlutusAssetClass
+	AssetX    PlutusAssetClass
+	AssetY    PlutusAssetClass
+	AssetLq   PlutusAssetClass
+	ExFee     int64
+	RewardPkh []byte
+	// StakePkh is nil when the reward address has no staking part
+	StakePkh []byte
+}
+
+func (s *SpectrumRedeemDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&s.PoolNft,
+			&s.AssetX,
+			&s.AssetY,
+			&s.AssetLq,
+			s.ExFee,
+			s.RewardPkh,
+			encodeSpectrumStakePkh(s.StakePkh),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SpectrumRedeemDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 7)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpStakePkh cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&s.PoolNft,
+		&s.AssetX,
+		&s.AssetY,
+		&s.AssetLq,
+		&s.ExFee,
+		&s.RewardPkh,
+		&tmpStakePkh,
+	); err != nil {
+		return err
+	}
+	stakePkh, err := decodeSpectrumStakePkh(tmpStakePkh)
+	if err != nil {
+		return err
+	}
+	s.StakePkh = stakePkh
+	return nil
+}
+
+// SplashPoolDatum represents the datum format used by the Splash (formerly Spectrum) fee
+// switch pool contract, which supports separate fees per direction and a protocol treasury
+type SplashPoolDatum struct {
+	PoolNft PlutusAssetClass
+	AssetX  PlutusAssetClass
+	AssetY  PlutusAssetClass
+	AssetLq PlutusAssetClass
+	// Fee numerators for swaps from X to Y and from Y to X
+	FeeNumX int64
+	FeeNumY int64
+	// Share of the swap fee that goes to the treasury
+	TreasuryFee int64
+	// Treasury amounts held in the pool UTxO which are not part of the reserves
+	TreasuryX int64
+	TreasuryY int64
+	// Staking credentials that are allowed to update the pool parameters
+	DaoPolicy []PlutusStakingCredential
+	// Minimum amount of liquidity that must remain in the pool
+	LqBound         int64
+	TreasuryAddress []byte
+}
+
+func (s *SplashPoolDatum) MarshalCBOR() ([]byte, error) {
+	var daoPolicy any = []any{}
+	if len(s.DaoPolicy) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(s.DaoPolicy))
+		for idx := range s.DaoPolicy {
+			tmpList = append(tmpList, &s.DaoPolicy[idx])
+		}
+		daoPolicy = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&s.PoolNft,
+			&s.AssetX,
+			&s.AssetY,
+			&s.AssetLq,
+			s.FeeNumX,
+			s.FeeNumY,
+			s.TreasuryFee,
+			s.TreasuryX,
+			s.TreasuryY,
+			daoPolicy,
+			s.LqBound,
+			s.TreasuryAddress,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SplashPoolDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 12)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(
+		fields,
+		&s.PoolNft,
+		&s.AssetX,
+		&s.AssetY,
+		&s.AssetLq,
+		&s.FeeNumX,
+		&s.FeeNumY,
+		&s.TreasuryFee,
+		&s.TreasuryX,
+		&s.TreasuryY,
+		&s.DaoPolicy,
+		&s.LqBound,
+		&s.TreasuryAddress,
+	)
+}
+
+// Reserves returns the pool reserves of asset X and asset Y from the pool UTxO value, excluding
+// the treasury amounts
+func (s SplashPoolDatum) Reserves(value PlutusValue) (int64, int64) {
+	return value.AssetAmount(s.AssetX) - s.TreasuryX, value.AssetAmount(s.AssetY) - s.TreasuryY
+}
+
+func encodeSpectrumStakePkh(stakePkh []byte) cbor.Constructor {
+	if stakePkh == nil {
+		return encodePlutusMaybe[[]byte](nil)
+	}
+	return encodePlutusMaybe(&stakePkh)
+}
+
+func decodeSpectrumStakePkh(cborData []byte) ([]byte, error) {
+	stakePkh, err := decodePlutusMaybe[[]byte](cborData)
+	if err != nil || stakePkh == nil {
+		return nil, err
+	}
+	return *stakePkh, nil
+}
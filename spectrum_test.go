@@ -0,0 +1,148 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+var (
+	testSpectrumPoolNft = models.PlutusAssetClass{
+		PolicyId:  decodeHex("0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb1"),
+		AssetName: []byte("spec_nft"),
+	}
+	testSpectrumLqAsset = models.PlutusAssetClass{
+		PolicyId:  decodeHex("0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb1"),
+		AssetName: []byte("spec_lq"),
+	}
+	testSpectrumMilkAsset = models.PlutusAssetClass{
+		PolicyId:  decodeHex("8a1cfae21368b8bebbbed9800fec304e95cce39a2a57dc35e2e3ebaa"),
+		AssetName: []byte("MILK"),
+	}
+	testSpectrumRewardPkh = decodeHex("1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5")
+	testSpectrumStakePkh  = decodeHex("5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809")
+)
+
+func TestSpectrumPoolDatumDecodeEncode(t *testing.T) {
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.SpectrumPoolDatum
+	}{
+		{
+			cborHex: "d8799fd8799f581c0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb148737065635f6e6674ffd8799f4040ffd8799f581c8a1cfae21368b8bebbbed9800fec304e95cce39a2a57dc35e2e3ebaa444d494c4bffd8799f581c0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb147737065635f6c71ff1903e59f581cddddddddddddddddddddddddddddddddddddddddddddddddddddddddff00ff",
+			expectedObj: models.SpectrumPoolDatum{
+				PoolNft: testSpectrumPoolNft,
+				AssetX:  testLovelaceAsset,
+				AssetY:  testSpectrumMilkAsset,
+				AssetLq: testSpectrumLqAsset,
+				FeeNum:  997,
+				StakeAdminPolicy: [][]byte{
+					decodeHex("dddddddddddddddddddddddddddddddddddddddddddddddddddddddd"),
+				},
+			},
+		},
+		{
+			cborHex: "d8799fd8799f581c0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb148737065635f6e6674ffd8799f4040ffd8799f581c8a1cfae21368b8bebbbed9800fec304e95cce39a2a57dc35e2e3ebaa444d494c4bffd8799f581c0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb147737065635f6c71ff1903e58000ff",
+			expectedObj: models.SpectrumPoolDatum{
+				PoolNft:          testSpectrumPoolNft,
+				AssetX:           testLovelaceAsset,
+				AssetY:           testSpectrumMilkAsset,
+				AssetLq:          testSpectrumLqAsset,
+				FeeNum:           997,
+				StakeAdminPolicy: [][]byte{},
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.SpectrumPoolDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+func TestSpectrumSwapDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799fd8799f4040ffd8799f581c8a1cfae21368b8bebbbed9800fec304e95cce39a2a57dc35e2e3ebaa444d494c4bffd8799f581c0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb148737065635f6e6674ff1903e51903e81a000f4240581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ff1a017d78401a0012d687ff"
+	expectedObj := models.SpectrumSwapDatum{
+		Base:             testLovelaceAsset,
+		Quote:            testSpectrumMilkAsset,
+		PoolNft:          testSpectrumPoolNft,
+		FeeNum:           997,
+		ExFeePerTokenNum: 1000,
+		ExFeePerTokenDen: 1000000,
+		RewardPkh:        testSpectrumRewardPkh,
+		StakePkh:         testSpectrumStakePkh,
+		BaseAmount:       25000000,
+		MinQuoteAmount:   1234567,
+	}
+	var testObj models.SpectrumSwapDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+}
+
+func TestSpectrumDepositDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799fd8799f581c0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb148737065635f6e6674ffd8799f4040ffd8799f581c8a1cfae21368b8bebbbed9800fec304e95cce39a2a57dc35e2e3ebaa444d494c4bffd8799f581c0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb147737065635f6c71ff1a001e8480581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d87a801a001e8480ff"
+	expectedObj := models.SpectrumDepositDatum{
+		PoolNft:       testSpectrumPoolNft,
+		AssetX:        testLovelaceAsset,
+		AssetY:        testSpectrumMilkAsset,
+		AssetLq:       testSpectrumLqAsset,
+		ExFee:         2000000,
+		RewardPkh:     testSpectrumRewardPkh,
+		CollateralAda: 2000000,
+	}
+	var testObj models.SpectrumDepositDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+}
+
+func TestSpectrumRedeemDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799fd8799f581c0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb148737065635f6e6674ffd8799f4040ffd8799f581c8a1cfae21368b8bebbbed9800fec304e95cce39a2a57dc35e2e3ebaa444d494c4bffd8799f581c0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb147737065635f6c71ff1a001e8480581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffff"
+	expectedObj := models.SpectrumRedeemDatum{
+		PoolNft:   testSpectrumPoolNft,
+		AssetX:    testLovelaceAsset,
+		AssetY:    testSpectrumMilkAsset,
+		AssetLq:   testSpectrumLqAsset,
+		ExFee:     2000000,
+		RewardPkh: testSpectrumRewardPkh,
+		StakePkh:  testSpectrumStakePkh,
+	}
+	var testObj models.SpectrumRedeemDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+}
+
+func TestSplashPoolDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799fd8799f581c0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb148737065635f6e6674ffd8799f4040ffd8799f581c8a1cfae21368b8bebbbed9800fec304e95cce39a2a57dc35e2e3ebaa444d494c4bffd8799f581c0be55d262b29f564998ff81efe21bdc0022621c12f15af08d0f2ddb147737065635f6c71ff1a000185741a000184ac0a1a0016e36018fa9fd8799fd87a9f581ceeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeffffff00581cffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+	expectedObj := models.SplashPoolDatum{
+		PoolNft:     testSpectrumPoolNft,
+		AssetX:      testLovelaceAsset,
+		AssetY:      testSpectrumMilkAsset,
+		AssetLq:     testSpectrumLqAsset,
+		FeeNumX:     99700,
+		FeeNumY:     99500,
+		TreasuryFee: 10,
+		TreasuryX:   1500000,
+		TreasuryY:   250,
+		DaoPolicy: []models.PlutusStakingCredential{
+			{
+				Credential: &models.PlutusCredential{
+					Type: models.PlutusCredentialTypeScript,
+					Hash: decodeHex("eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"),
+				},
+			},
+		},
+		TreasuryAddress: decodeHex("ffffffffffffffffffffffffffffffffffffffffffffffffffffffff"),
+	}
+	var testObj models.SplashPoolDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+}
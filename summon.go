@@ -0,0 +1,148 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// SummonProposalDatum represents a governance proposal held by the Summon DAO contract
+type SummonProposalDatum struct {
+	ProposalId []byte
+	// Proposer is the public key hash of the proposal creator
+	Proposer []byte
+	// GovernanceToken is the token used to weight votes
+	GovernanceToken PlutusAssetClass
+	// Tallies holds the accumulated vote weight for each option, in option order
+	Tallies []int64
+	// Times are in POSIX milliseconds
+	StartTime int64
+	EndTime   int64
+	// Quorum is the minimum total vote weight for the proposal to pass
+	Quorum int64
+}
+
+func (s *SummonProposalDatum) MarshalCBOR() ([]byte, error) {
+	var tallies any = []any{}
+	if len(s.Tallies) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(s.Tallies))
+		for _, tally := range s.Tallies {
+			tmpList = append(tmpList, tally)
+		}
+		tallies = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			s.ProposalId,
+			s.Proposer,
+			&s.GovernanceToken,
+			tallies,
+			s.StartTime,
+			s.EndTime,
+			s.Quorum,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SummonProposalDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 7)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(
+		fields,
+		&s.ProposalId,
+		&s.Proposer,
+		&s.GovernanceToken,
+		&s.Tallies,
+		&s.StartTime,
+		&s.EndTime,
+		&s.Quorum,
+	)
+}
+
+// TotalVotes returns the total vote weight cast across all options
+func (s SummonProposalDatum) TotalVotes() int64 {
+	var total int64
+	for _, tally := range s.Tallies {
+		total += tally
+	}
+	return total
+}
+
+// QuorumReached returns true if the total vote weight meets the proposal quorum
+func (s SummonProposalDatum) QuorumReached() bool {
+	return s.TotalVotes() >= s.Quorum
+}
+
+// LeadingOption returns the index of the option with the highest vote weight, or -1 if the
+// proposal has no options. Ties are resolved in favor of the lowest index
+func (s SummonProposalDatum) LeadingOption() int {
+	leading := -1
+	for idx, tally := range s.Tallies {
+		if leading < 0 || tally > s.Tallies[leading] {
+			leading = idx
+		}
+	}
+	return leading
+}
+
+// SummonVoteReceiptDatum represents the receipt held by the Summon DAO contract for a vote
+// cast on a proposal
+type SummonVoteReceiptDatum struct {
+	ProposalId []byte
+	// Voter is the public key hash of the voter
+	Voter       []byte
+	OptionIndex int64
+	// Weight is the amount of governance token committed to the vote
+	Weight int64
+}
+
+func (s *SummonVoteReceiptDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			s.ProposalId,
+			s.Voter,
+			s.OptionIndex,
+			s.Weight,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SummonVoteReceiptDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 4)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(
+		fields,
+		&s.ProposalId,
+		&s.Voter,
+		&s.OptionIndex,
+		&s.Weight,
+	)
+}
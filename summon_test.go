@@ -0,0 +1,60 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"bytes"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+var testSummonProposalId = bytes.Repeat([]byte{0xa1}, 32)
+
+func TestSummonProposalDatumDecodeEncode(t *testing.T) {
+	testCborHex := "d8799f5820a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1581cb2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2d8799f581c29d222ce763455e3d7a09a665ce554f00ac89d2e99a1a83d267170c6434d494eff9f1a00124f801a0005573000ff1b000001941f297c001b00000194433600001a000f4240ff"
+	expectedObj := models.SummonProposalDatum{
+		ProposalId:      testSummonProposalId,
+		Proposer:        bytes.Repeat([]byte{0xb2}, 28),
+		GovernanceToken: testMinAsset,
+		Tallies:         []int64{1200000, 350000, 0},
+		StartTime:       1735689600000,
+		EndTime:         1736294400000,
+		Quorum:          1000000,
+	}
+	var testObj models.SummonProposalDatum
+	testDecodeEncode(t, testCborHex, &testObj, &expectedObj)
+	if testObj.TotalVotes() != 1550000 {
+		t.Fatalf("did not get expected total votes: got %d", testObj.TotalVotes())
+	}
+	if !testObj.QuorumReached() {
+		t.Fatalf("expected quorum to be reached")
+	}
+	if testObj.LeadingOption() != 0 {
+		t.Fatalf("did not get expected leading option: got %d", testObj.LeadingOption())
+	}
+}
+
+func TestSummonVoteReceiptDatumDecodeEncode(t *testing.T) {
+	testCborHex := "d8799f5820a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1a1581cc3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3c3011a0003d090ff"
+	expectedObj := models.SummonVoteReceiptDatum{
+		ProposalId:  testSummonProposalId,
+		Voter:       bytes.Repeat([]byte{0xc3}, 28),
+		OptionIndex: 1,
+		Weight:      250000,
+	}
+	var testObj models.SummonVoteReceiptDatum
+	testDecodeEncode(t, testCborHex, &testObj, &expectedObj)
+}
@@ -0,0 +1,970 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// SundaeSwapIdent is the identifier of a SundaeSwap pool. It is stored on chain as raw bytes
+// and usually displayed as hex
+type SundaeSwapIdent []byte
+
+// ParseSundaeSwapIdent parses a hex-encoded SundaeSwap pool ident
+func ParseSundaeSwapIdent(identHex string) (SundaeSwapIdent, error) {
+	ident, err := hex.DecodeString(identHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pool ident: %w: %w", ErrNotHex, err)
+	}
+	return SundaeSwapIdent(ident), nil
+}
+
+func (s SundaeSwapIdent) String() string {
+	return hex.EncodeToString(s)
+}
+
+// SundaeSwapV1PoolDatum represents the datum format used by the SundaeSwap V1 pool contract
+type SundaeSwapV1PoolDatum struct {
+	AssetA        PlutusAssetClass
+	AssetB        PlutusAssetClass
+	Ident         SundaeSwapIdent
+	CirculatingLp int64
+	// The swap fee is FeeNumerator / FeeDenominator
+	FeeNumerator   int64
+	FeeDenominator int64
+}
+
+func (s *SundaeSwapV1PoolDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			cbor.NewConstructor(
+				0,
+				cbor.IndefLengthList{
+					&s.AssetA,
+					&s.AssetB,
+				},
+			),
+			[]byte(s.Ident),
+			s.CirculatingLp,
+			cbor.NewConstructor(
+				0,
+				cbor.IndefLengthList{
+					s.FeeNumerator,
+					s.FeeDenominator,
+				},
+			),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SundaeSwapV1PoolDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 4)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpCoins, tmpFees cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&tmpCoins,
+		&s.Ident,
+		&s.CirculatingLp,
+		&tmpFees,
+	); err != nil {
+		return err
+	}
+	_, coinFields, err := decodePlutusConstr(tmpCoins, 2)
+	if err != nil {
+		return err
+	}
+	if err := decodePlutusFields(coinFields, &s.AssetA, &s.AssetB); err != nil {
+		return err
+	}
+	_, feeFields, err := decodePlutusConstr(tmpFees, 2)
+	if err != nil {
+		return err
+	}
+	return decodePlutusFields(feeFields, &s.FeeNumerator, &s.FeeDenominator)
+}
+
+// Reserves returns the pool reserves of asset A and asset B from the pool UTxO value
+func (s SundaeSwapV1PoolDatum) Reserves(value PlutusValue) (int64, int64) {
+	return value.AssetAmount(s.AssetA), value.AssetAmount(s.AssetB)
+}
+
+// SundaeSwapV1Destination specifies where the result of an order is sent
+type SundaeSwapV1Destination struct {
+	Address PlutusAddress
+	// DatumHash is nil when the output has no datum
+	DatumHash []byte
+}
+
+func (s *SundaeSwapV1Destination) MarshalCBOR() ([]byte, error) {
+	var datumHash *[]byte
+	if s.DatumHash != nil {
+		datumHash = &s.DatumHash
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&s.Address,
+			encodePlutusMaybe(datumHash),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SundaeSwapV1Destination) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpDatumHash cbor.RawMessage
+	if err := decodePlutusFields(fields, &s.Address, &tmpDatumHash); err != nil {
+		return err
+	}
+	datumHash, err := decodePlutusMaybe[[]byte](tmpDatumHash)
+	if err != nil {
+		return err
+	}
+	s.DatumHash = nil
+	if datumHash != nil {
+		s.DatumHash = *datumHash
+	}
+	return nil
+}
+
+// SundaeSwapV1OrderAddresses specifies the destination of an order and an optional alternate
+// key which may also cancel the order
+type SundaeSwapV1OrderAddresses struct {
+	Destination SundaeSwapV1Destination
+	// Alternate is the public key hash of an alternate canceller, or nil if not set
+	Alternate []byte
+}
+
+func (s *SundaeSwapV1OrderAddresses) MarshalCBOR() ([]byte, error) {
+	var alternate *[]byte
+	if s.Alternate != nil {
+		alternate = &s.Alternate
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&s.Destination,
+			encodePlutusMaybe(alternate),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SundaeSwapV1OrderAddresses) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpAlternate cbor.RawMessage
+	if err := decodePlutusFields(fields, &s.Destination, &tmpAlternate); err != nil {
+		return err
+	}
+	alternate, err := decodePlutusMaybe[[]byte](tmpAlternate)
+	if err != nil {
+		return err
+	}
+	s.Alternate = nil
+	if alternate != nil {
+		s.Alternate = *alternate
+	}
+	return nil
+}
+
+// SundaeSwapV1ActionType identifies the type of a SundaeSwap V1 order
+type SundaeSwapV1ActionType uint
+
+const (
+	SundaeSwapV1ActionSwap     SundaeSwapV1ActionType = 0
+	SundaeSwapV1ActionWithdraw SundaeSwapV1ActionType = 1
+	SundaeSwapV1ActionDeposit  SundaeSwapV1ActionType = 2
+)
+
+// SundaeSwapV1Action represents the action requested by a SundaeSwap V1 order. Only the
+// fields relevant to the action type are used
+type SundaeSwapV1Action struct {
+	Type SundaeSwapV1ActionType
+	// Used by Swap and single-asset Deposit. CoinB is true when giving asset B (in exchange for
+	// asset A, for Swap)
+	CoinB  bool
+	Amount int64
+	// MinimumReceive is nil when the swap has no minimum
+	MinimumReceive *int64
+	// Used by Withdraw
+	LpAmount int64
+	// Used by Deposit. DepositSingle is true for a deposit of only one asset, selected by CoinB
+	// with the amount in Amount. Otherwise both assets are deposited, using AmountA and AmountB
+	DepositSingle bool
+	AmountA       int64
+	AmountB       int64
+}
+
+func (s *SundaeSwapV1Action) MarshalCBOR() ([]byte, error) {
+	var tmp cbor.Constructor
+	switch s.Type {
+	case SundaeSwapV1ActionSwap:
+		tmp = cbor.NewConstructor(
+			uint(s.Type),
+			cbor.IndefLengthList{
+				s.encodeCoin(),
+				s.Amount,
+				encodePlutusMaybe(s.MinimumReceive),
+			},
+		)
+	case SundaeSwapV1ActionWithdraw:
+		tmp = cbor.NewConstructor(
+			uint(s.Type),
+			cbor.IndefLengthList{
+				s.LpAmount,
+			},
+		)
+	case SundaeSwapV1ActionDeposit:
+		if s.DepositSingle {
+			tmp = cbor.NewConstructor(
+				uint(s.Type),
+				cbor.IndefLengthList{
+					cbor.NewConstructor(
+						0,
+						cbor.IndefLengthList{
+							s.encodeCoin(),
+							s.Amount,
+						},
+					),
+				},
+			)
+			break
+		}
+		tmp = cbor.NewConstructor(
+			uint(s.Type),
+			cbor.IndefLengthList{
+				cbor.NewConstructor(
+					1,
+					cbor.IndefLengthList{
+						cbor.NewConstructor(
+							0,
+							cbor.IndefLengthList{
+								s.AmountA,
+								s.AmountB,
+							},
+						),
+					},
+				),
+			},
+		)
+	default:
+		return nil, fmt.Errorf("unknown action type: %d", s.Type)
+	}
+	return cbor.Encode(&tmp)
+}
+
+// encodeCoin returns the Plutus encoding of the coin selected by CoinB
+func (s *SundaeSwapV1Action) encodeCoin() cbor.Constructor {
+	var coinConstr uint
+	if s.CoinB {
+		coinConstr = 1
+	}
+	return cbor.NewConstructor(coinConstr, []any{})
+}
+
+func (s *SundaeSwapV1Action) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*s = SundaeSwapV1Action{
+		Type: SundaeSwapV1ActionType(constr),
+	}
+	switch s.Type {
+	case SundaeSwapV1ActionSwap:
+		var tmpCoin, tmpMinimum cbor.RawMessage
+		if err := decodePlutusFields(fields, &tmpCoin, &s.Amount, &tmpMinimum); err != nil {
+			return err
+		}
+		coinB, err := decodePlutusBool(tmpCoin)
+		if err != nil {
+			return err
+		}
+		s.CoinB = coinB
+		minimum, err := decodePlutusMaybe[int64](tmpMinimum)
+		if err != nil {
+			return err
+		}
+		s.MinimumReceive = minimum
+	case SundaeSwapV1ActionWithdraw:
+		return decodePlutusFields(fields, &s.LpAmount)
+	case SundaeSwapV1ActionDeposit:
+		var tmpDeposit cbor.RawMessage
+		if err := decodePlutusFields(fields, &tmpDeposit); err != nil {
+			return err
+		}
+		depositConstr, depositFields, err := decodePlutusConstr(tmpDeposit, -1)
+		if err != nil {
+			return err
+		}
+		switch depositConstr {
+		case 0:
+			// Single asset deposit
+			var tmpCoin cbor.RawMessage
+			if err := decodePlutusFields(depositFields, &tmpCoin, &s.Amount); err != nil {
+				return err
+			}
+			coinB, err := decodePlutusBool(tmpCoin)
+			if err != nil {
+				return err
+			}
+			s.DepositSingle = true
+			s.CoinB = coinB
+		case 1:
+			// Mixed deposit of both assets
+			var tmpAmounts cbor.RawMessage
+			if err := decodePlutusFields(depositFields, &tmpAmounts); err != nil {
+				return err
+			}
+			_, amountFields, err := decodePlutusConstr(tmpAmounts, 2)
+			if err != nil {
+				return err
+			}
+			return decodePlutusFields(amountFields, &s.AmountA, &s.AmountB)
+		default:
+			return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, depositConstr)
+		}
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return nil
+}
+
+// SundaeSwapV1EscrowDatum represents the datum format used by the SundaeSwap V1 escrow (order)
+// contract
+type SundaeSwapV1EscrowDatum struct {
+	Ident      SundaeSwapIdent
+	Addresses  SundaeSwapV1OrderAddresses
+	ScooperFee int64
+	Action     SundaeSwapV1Action
+}
+
+func (s *SundaeSwapV1EscrowDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			[]byte(s.Ident),
+			&s.Addresses,
+			s.ScooperFee,
+			&s.Action,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SundaeSwapV1EscrowDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 4)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(
+		fields,
+		&s.Ident,
+		&s.Addresses,
+		&s.ScooperFee,
+		&s.Action,
+	)
+}
+
+// SundaeSwapV3MultisigScriptType identifies the kind of a SundaeSwap V3 multisig script
+type SundaeSwapV3MultisigScriptType uint
+
+const (
+	SundaeSwapV3MultisigTypeSignature SundaeSwapV3MultisigScriptType = 0
+	SundaeSwapV3MultisigTypeAllOf     SundaeSwapV3MultisigScriptType = 1
+	SundaeSwapV3MultisigTypeAnyOf     SundaeSwapV3MultisigScriptType = 2
+	SundaeSwapV3MultisigTypeAtLeast   SundaeSwapV3MultisigScriptType = 3
+	SundaeSwapV3MultisigTypeBefore    SundaeSwapV3MultisigScriptType = 4
+	SundaeSwapV3MultisigTypeAfter     SundaeSwapV3MultisigScriptType = 5
+	SundaeSwapV3MultisigTypeScript    SundaeSwapV3MultisigScriptType = 6
+)
+
+// SundaeSwapV3MultisigScript represents the multisig conditions used by SundaeSwap V3 for order
+// owners and protocol admins. Only the fields relevant to the script type are used
+type SundaeSwapV3MultisigScript struct {
+	Type SundaeSwapV3MultisigScriptType
+	// Key hash for Signature, script hash for Script
+	Hash     []byte
+	Required int64
+	Scripts  []SundaeSwapV3MultisigScript
+	// POSIX time in milliseconds for Before and After
+	Time int64
+}
+
+func (s *SundaeSwapV3MultisigScript) MarshalCBOR() ([]byte, error) {
+	var fields cbor.IndefLengthList
+	switch s.Type {
+	case SundaeSwapV3MultisigTypeSignature, SundaeSwapV3MultisigTypeScript:
+		fields = cbor.IndefLengthList{s.Hash}
+	case SundaeSwapV3MultisigTypeAllOf, SundaeSwapV3MultisigTypeAnyOf:
+		fields = cbor.IndefLengthList{s.encodeScripts()}
+	case SundaeSwapV3MultisigTypeAtLeast:
+		fields = cbor.IndefLengthList{s.Required, s.encodeScripts()}
+	case SundaeSwapV3MultisigTypeBefore, SundaeSwapV3MultisigTypeAfter:
+		fields = cbor.IndefLengthList{s.Time}
+	default:
+		return nil, fmt.Errorf("unknown multisig script type: %d", s.Type)
+	}
+	tmp := cbor.NewConstructor(uint(s.Type), fields)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SundaeSwapV3MultisigScript) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*s = SundaeSwapV3MultisigScript{
+		Type: SundaeSwapV3MultisigScriptType(constr),
+	}
+	switch s.Type {
+	case SundaeSwapV3MultisigTypeSignature, SundaeSwapV3MultisigTypeScript:
+		return decodePlutusFields(fields, &s.Hash)
+	case SundaeSwapV3MultisigTypeAllOf, SundaeSwapV3MultisigTypeAnyOf:
+		return decodePlutusFields(fields, &s.Scripts)
+	case SundaeSwapV3MultisigTypeAtLeast:
+		return decodePlutusFields(fields, &s.Required, &s.Scripts)
+	case SundaeSwapV3MultisigTypeBefore, SundaeSwapV3MultisigTypeAfter:
+		return decodePlutusFields(fields, &s.Time)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+func (s *SundaeSwapV3MultisigScript) encodeScripts() any {
+	if len(s.Scripts) == 0 {
+		return []any{}
+	}
+	ret := make(cbor.IndefLengthList, 0, len(s.Scripts))
+	for idx := range s.Scripts {
+		ret = append(ret, &s.Scripts[idx])
+	}
+	return ret
+}
+
+// SundaeSwapV3PoolDatum represents the datum format used by the SundaeSwap V3 pool contract
+type SundaeSwapV3PoolDatum struct {
+	Ident         SundaeSwapIdent
+	AssetA        PlutusAssetClass
+	AssetB        PlutusAssetClass
+	CirculatingLp int64
+	// Fees are expressed in basis points
+	BidFeesPer10Thousand int64
+	AskFeesPer10Thousand int64
+	// FeeManager is nil when the pool fees cannot be updated
+	FeeManager *SundaeSwapV3MultisigScript
+	// POSIX time in milliseconds
+	MarketOpen   int64
+	ProtocolFees int64
+}
+
+func (s *SundaeSwapV3PoolDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			[]byte(s.Ident),
+			cbor.IndefLengthList{
+				cbor.IndefLengthList{s.AssetA.PolicyId, s.AssetA.AssetName},
+				cbor.IndefLengthList{s.AssetB.PolicyId, s.AssetB.AssetName},
+			},
+			s.CirculatingLp,
+			s.BidFeesPer10Thousand,
+			s.AskFeesPer10Thousand,
+			encodePlutusMaybe(s.FeeManager),
+			s.MarketOpen,
+			s.ProtocolFees,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SundaeSwapV3PoolDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 8)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	// Aiken encodes tuples as plain lists
+	var tmpAssets struct {
+		cbor.StructAsArray
+		AssetA struct {
+			cbor.StructAsArray
+			PolicyId  []byte
+			AssetName []byte
+		}
+		AssetB struct {
+			cbor.StructAsArray
+			PolicyId  []byte
+			AssetName []byte
+		}
+	}
+	var tmpFeeManager cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&s.Ident,
+		&tmpAssets,
+		&s.CirculatingLp,
+		&s.BidFeesPer10Thousand,
+		&s.AskFeesPer10Thousand,
+		&tmpFeeManager,
+		&s.MarketOpen,
+		&s.ProtocolFees,
+	); err != nil {
+		return err
+	}
+	s.AssetA = PlutusAssetClass{
+		PolicyId:  tmpAssets.AssetA.PolicyId,
+		AssetName: tmpAssets.AssetA.AssetName,
+	}
+	s.AssetB = PlutusAssetClass{
+		PolicyId:  tmpAssets.AssetB.PolicyId,
+		AssetName: tmpAssets.AssetB.AssetName,
+	}
+	feeManager, err := decodePlutusMaybe[SundaeSwapV3MultisigScript](tmpFeeManager)
+	if err != nil {
+		return err
+	}
+	s.FeeManager = feeManager
+	return nil
+}
+
+// Reserves returns the pool reserves of asset A and asset B from the pool UTxO value. The
+// protocol fees are held in the pool UTxO as ADA, so they are excluded from the reserve when
+// asset A is ADA
+func (s SundaeSwapV3PoolDatum) Reserves(value PlutusValue) (int64, int64) {
+	reserveA := value.AssetAmount(s.AssetA)
+	if s.AssetA.IsLovelace() {
+		reserveA -= s.ProtocolFees
+	}
+	return reserveA, value.AssetAmount(s.AssetB)
+}
+
+// SundaeSwapV3SingletonValue represents a quantity of a single asset
+type SundaeSwapV3SingletonValue struct {
+	// This allows the type to be used with cbor.DecodeGeneric
+	cbor.StructAsArray
+	PolicyId  []byte
+	AssetName []byte
+	Amount    int64
+}
+
+func (s *SundaeSwapV3SingletonValue) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.IndefLengthList{
+		s.PolicyId,
+		s.AssetName,
+		s.Amount,
+	}
+	return cbor.Encode(&tmp)
+}
+
+func (s *SundaeSwapV3SingletonValue) UnmarshalCBOR(cborData []byte) error {
+	return cbor.DecodeGeneric(cborData, s)
+}
+
+// SundaeSwapV3DatumType identifies the kind of datum attached to an order destination
+type SundaeSwapV3DatumType uint
+
+const (
+	SundaeSwapV3DatumNone   SundaeSwapV3DatumType = 0
+	SundaeSwapV3DatumHash   SundaeSwapV3DatumType = 1
+	SundaeSwapV3DatumInline SundaeSwapV3DatumType = 2
+)
+
+// SundaeSwapV3Destination specifies where the result of an order is sent. When SelfDestination
+// is true, the output is sent back to the order script with the same datum, and the other
+// fields are not used
+type SundaeSwapV3Destination struct {
+	SelfDestination bool
+	Address         PlutusAddress
+	DatumType       SundaeSwapV3DatumType
+	// Datum hash for DatumHash, or the raw datum CBOR for InlineDatum
+	Datum []byte
+}
+
+func (s *SundaeSwapV3Destination) MarshalCBOR() ([]byte, error) {
+	if s.SelfDestination {
+		tmp := cbor.NewConstructor(1, []any{})
+		return cbor.Encode(&tmp)
+	}
+	var tmpDatum cbor.Constructor
+	switch s.DatumType {
+	case SundaeSwapV3DatumNone:
+		tmpDatum = cbor.NewConstructor(uint(s.DatumType), []any{})
+	case SundaeSwapV3DatumHash:
+		tmpDatum = cbor.NewConstructor(uint(s.DatumType), cbor.IndefLengthList{s.Datum})
+	case SundaeSwapV3DatumInline:
+		tmpDatum = cbor.NewConstructor(
+			uint(s.DatumType),
+			cbor.IndefLengthList{cbor.RawMessage(s.Datum)},
+		)
+	default:
+		return nil, fmt.Errorf("unknown datum type: %d", s.DatumType)
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&s.Address,
+			tmpDatum,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SundaeSwapV3Destination) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*s = SundaeSwapV3Destination{}
+	switch constr {
+	case 0:
+		var tmpDatum cbor.RawMessage
+		if err := decodePlutusFields(fields, &s.Address, &tmpDatum); err != nil {
+			return err
+		}
+		datumConstr, datumFields, err := decodePlutusConstr(tmpDatum, -1)
+		if err != nil {
+			return err
+		}
+		s.DatumType = SundaeSwapV3DatumType(datumConstr)
+		switch s.DatumType {
+		case SundaeSwapV3DatumNone:
+			return decodePlutusFields(datumFields)
+		case SundaeSwapV3DatumHash:
+			return decodePlutusFields(datumFields, &s.Datum)
+		case SundaeSwapV3DatumInline:
+			var tmpInline cbor.RawMessage
+			if err := decodePlutusFields(datumFields, &tmpInline); err != nil {
+				return err
+			}
+			s.Datum = []byte(tmpInline)
+			return nil
+		default:
+			return fmt.Errorf("%w: datum %d", ErrUnexpectedConstructor, datumConstr)
+		}
+	case 1:
+		s.SelfDestination = true
+		return decodePlutusFields(fields)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// SundaeSwapV3OrderType identifies the type of a SundaeSwap V3 order
+type SundaeSwapV3OrderType uint
+
+const (
+	SundaeSwapV3OrderStrategy   SundaeSwapV3OrderType = 0
+	SundaeSwapV3OrderSwap       SundaeSwapV3OrderType = 1
+	SundaeSwapV3OrderDeposit    SundaeSwapV3OrderType = 2
+	SundaeSwapV3OrderWithdrawal SundaeSwapV3OrderType = 3
+	SundaeSwapV3OrderDonation   SundaeSwapV3OrderType = 4
+	SundaeSwapV3OrderRecord     SundaeSwapV3OrderType = 5
+)
+
+// SundaeSwapV3OrderDetails represents the action requested by a SundaeSwap V3 order. Only the
+// fields relevant to the order type are used
+type SundaeSwapV3OrderDetails struct {
+	Type SundaeSwapV3OrderType
+	// Used by Strategy. StrategyScript is true when the strategy is authorized by a script
+	// rather than a signature
+	StrategyScript bool
+	StrategySigner []byte
+	// Used by Swap (offer and minimum received), Deposit and Donation (both assets) and
+	// Withdrawal (LP amount in AssetA)
+	AssetA SundaeSwapV3SingletonValue
+	AssetB SundaeSwapV3SingletonValue
+	// Used by Record
+	RecordPolicy PlutusAssetClass
+}
+
+func (s *SundaeSwapV3OrderDetails) MarshalCBOR() ([]byte, error) {
+	var fields cbor.IndefLengthList
+	switch s.Type {
+	case SundaeSwapV3OrderStrategy:
+		var authConstr uint
+		if s.StrategyScript {
+			authConstr = 1
+		}
+		fields = cbor.IndefLengthList{
+			cbor.NewConstructor(authConstr, cbor.IndefLengthList{s.StrategySigner}),
+		}
+	case SundaeSwapV3OrderSwap:
+		fields = cbor.IndefLengthList{&s.AssetA, &s.AssetB}
+	case SundaeSwapV3OrderDeposit, SundaeSwapV3OrderDonation:
+		fields = cbor.IndefLengthList{
+			cbor.IndefLengthList{&s.AssetA, &s.AssetB},
+		}
+	case SundaeSwapV3OrderWithdrawal:
+		fields = cbor.IndefLengthList{&s.AssetA}
+	case SundaeSwapV3OrderRecord:
+		fields = cbor.IndefLengthList{
+			cbor.IndefLengthList{s.RecordPolicy.PolicyId, s.RecordPolicy.AssetName},
+		}
+	default:
+		return nil, fmt.Errorf("unknown order type: %d", s.Type)
+	}
+	tmp := cbor.NewConstructor(uint(s.Type), fields)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SundaeSwapV3OrderDetails) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*s = SundaeSwapV3OrderDetails{
+		Type: SundaeSwapV3OrderType(constr),
+	}
+	switch s.Type {
+	case SundaeSwapV3OrderStrategy:
+		var tmpAuth cbor.RawMessage
+		if err := decodePlutusFields(fields, &tmpAuth); err != nil {
+			return err
+		}
+		authConstr, authFields, err := decodePlutusConstr(tmpAuth, 1)
+		if err != nil {
+			return err
+		}
+		if authConstr > 1 {
+			return fmt.Errorf("%w: strategy authorization %d", ErrUnexpectedConstructor, authConstr)
+		}
+		s.StrategyScript = authConstr == 1
+		return decodePlutusFields(authFields, &s.StrategySigner)
+	case SundaeSwapV3OrderSwap:
+		return decodePlutusFields(fields, &s.AssetA, &s.AssetB)
+	case SundaeSwapV3OrderDeposit, SundaeSwapV3OrderDonation:
+		var tmpAssets struct {
+			cbor.StructAsArray
+			AssetA SundaeSwapV3SingletonValue
+			AssetB SundaeSwapV3SingletonValue
+		}
+		if err := decodePlutusFields(fields, &tmpAssets); err != nil {
+			return err
+		}
+		s.AssetA = tmpAssets.AssetA
+		s.AssetB = tmpAssets.AssetB
+		return nil
+	case SundaeSwapV3OrderWithdrawal:
+		return decodePlutusFields(fields, &s.AssetA)
+	case SundaeSwapV3OrderRecord:
+		var tmpPolicy struct {
+			cbor.StructAsArray
+			PolicyId  []byte
+			AssetName []byte
+		}
+		if err := decodePlutusFields(fields, &tmpPolicy); err != nil {
+			return err
+		}
+		s.RecordPolicy = PlutusAssetClass{
+			PolicyId:  tmpPolicy.PolicyId,
+			AssetName: tmpPolicy.AssetName,
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// SundaeSwapV3OrderDatum represents the datum format used by the SundaeSwap V3 order contract
+type SundaeSwapV3OrderDatum struct {
+	// Ident is nil when the order may be executed against any pool
+	Ident          SundaeSwapIdent
+	Owner          SundaeSwapV3MultisigScript
+	MaxProtocolFee int64
+	Destination    SundaeSwapV3Destination
+	Details        SundaeSwapV3OrderDetails
+	// Raw CBOR of the extension data
+	Extension cbor.RawMessage
+}
+
+func (s *SundaeSwapV3OrderDatum) MarshalCBOR() ([]byte, error) {
+	var ident *[]byte
+	if s.Ident != nil {
+		tmpIdent := []byte(s.Ident)
+		ident = &tmpIdent
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			encodePlutusMaybe(ident),
+			&s.Owner,
+			s.MaxProtocolFee,
+			&s.Destination,
+			&s.Details,
+			s.Extension,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SundaeSwapV3OrderDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 6)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpIdent cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&tmpIdent,
+		&s.Owner,
+		&s.MaxProtocolFee,
+		&s.Destination,
+		&s.Details,
+		&s.Extension,
+	); err != nil {
+		return err
+	}
+	ident, err := decodePlutusMaybe[[]byte](tmpIdent)
+	if err != nil {
+		return err
+	}
+	s.Ident = nil
+	if ident != nil {
+		s.Ident = SundaeSwapIdent(*ident)
+	}
+	return nil
+}
+
+// SundaeSwapV3SettingsDatum represents the datum format used by the SundaeSwap V3 settings
+// contract, which holds the protocol-wide parameters
+type SundaeSwapV3SettingsDatum struct {
+	SettingsAdmin     SundaeSwapV3MultisigScript
+	MetadataAdmin     PlutusAddress
+	TreasuryAdmin     SundaeSwapV3MultisigScript
+	TreasuryAddress   PlutusAddress
+	TreasuryAllowance [2]int64
+	// AuthorizedScoopers is nil when anybody may scoop
+	AuthorizedScoopers    [][]byte
+	AuthorizedStakingKeys []PlutusCredential
+	BaseFee               int64
+	SimpleFee             int64
+	StrategyFee           int64
+	PoolCreationFee       int64
+	// Raw CBOR of the extension data
+	Extensions cbor.RawMessage
+}
+
+func (s *SundaeSwapV3SettingsDatum) MarshalCBOR() ([]byte, error) {
+	var scoopers *any
+	if s.AuthorizedScoopers != nil {
+		var tmpScoopers any = []any{}
+		if len(s.AuthorizedScoopers) > 0 {
+			tmpList := make(cbor.IndefLengthList, 0, len(s.AuthorizedScoopers))
+			for _, scooper := range s.AuthorizedScoopers {
+				tmpList = append(tmpList, scooper)
+			}
+			tmpScoopers = tmpList
+		}
+		scoopers = &tmpScoopers
+	}
+	var stakingKeys any = []any{}
+	if len(s.AuthorizedStakingKeys) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(s.AuthorizedStakingKeys))
+		for idx := range s.AuthorizedStakingKeys {
+			tmpList = append(tmpList, &s.AuthorizedStakingKeys[idx])
+		}
+		stakingKeys = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&s.SettingsAdmin,
+			&s.MetadataAdmin,
+			&s.TreasuryAdmin,
+			&s.TreasuryAddress,
+			cbor.IndefLengthList{s.TreasuryAllowance[0], s.TreasuryAllowance[1]},
+			encodePlutusMaybe(scoopers),
+			stakingKeys,
+			s.BaseFee,
+			s.SimpleFee,
+			s.StrategyFee,
+			s.PoolCreationFee,
+			s.Extensions,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (s *SundaeSwapV3SettingsDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 12)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	var tmpScoopers cbor.RawMessage
+	if err := decodePlutusFields(
+		fields,
+		&s.SettingsAdmin,
+		&s.MetadataAdmin,
+		&s.TreasuryAdmin,
+		&s.TreasuryAddress,
+		&s.TreasuryAllowance,
+		&tmpScoopers,
+		&s.AuthorizedStakingKeys,
+		&s.BaseFee,
+		&s.SimpleFee,
+		&s.StrategyFee,
+		&s.PoolCreationFee,
+		&s.Extensions,
+	); err != nil {
+		return err
+	}
+	scoopers, err := decodePlutusMaybe[[][]byte](tmpScoopers)
+	if err != nil {
+		return err
+	}
+	s.AuthorizedScoopers = nil
+	if scoopers != nil {
+		s.AuthorizedScoopers = *scoopers
+		if s.AuthorizedScoopers == nil {
+			s.AuthorizedScoopers = [][]byte{}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,393 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"errors"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+var testSundaeAsset = models.PlutusAssetClass{
+	PolicyId:  decodeHex("9a9693a9a37912a5097918f97918d15240c92ab729a0b7c4aa144d77"),
+	AssetName: []byte("SUNDAE"),
+}
+
+func TestSundaeSwapIdent(t *testing.T) {
+	ident, err := models.ParseSundaeSwapIdent("1f")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if ident.String() != "1f" {
+		t.Fatalf("did not get expected ident: got %s", ident.String())
+	}
+	if _, err := models.ParseSundaeSwapIdent("zz"); err == nil {
+		t.Fatalf("did not get expected error parsing invalid ident")
+	}
+}
+
+// The SundaeSwap V1 pool and escrow fixtures are synthetic rather than mainnet samples, so they
+// only cover round-tripping. They should be replaced with mainnet datums, noting the tx hash of each
+func TestSundaeSwapV1PoolDatumDecodeEncode(t *testing.T) {
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.SundaeSwapV1PoolDatum
+	}{
+		{
+			cborHex: "d8799fd8799fd8799f4040ffd8799f581c9a9693a9a37912a5097918f97918d15240c92ab729a0b7c4aa144d774653554e444145ffff41011b0000001cbe991a14d8799f031903e8ffff",
+			expectedObj: models.SundaeSwapV1PoolDatum{
+				AssetA:         testLovelaceAsset,
+				AssetB:         testSundaeAsset,
+				Ident:          models.SundaeSwapIdent{0x01},
+				CirculatingLp:  123456789012,
+				FeeNumerator:   3,
+				FeeDenominator: 1000,
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.SundaeSwapV1PoolDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+func TestSundaeSwapV1EscrowDatumDecodeEncode(t *testing.T) {
+	minimumReceive := int64(12345)
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.SundaeSwapV1EscrowDatum
+	}{
+		{
+			// Swap
+			cborHex: "d8799f4101d8799fd8799f" + testPlutusAddressBaseHex + "d87a80ffd87a80ff1a002625a0d8799fd879801a02faf080d8799f193039ffffff",
+			expectedObj: models.SundaeSwapV1EscrowDatum{
+				Ident: models.SundaeSwapIdent{0x01},
+				Addresses: models.SundaeSwapV1OrderAddresses{
+					Destination: models.SundaeSwapV1Destination{
+						Address: testPlutusAddressBase,
+					},
+				},
+				ScooperFee: 2500000,
+				Action: models.SundaeSwapV1Action{
+					Type:           models.SundaeSwapV1ActionSwap,
+					Amount:         50000000,
+					MinimumReceive: &minimumReceive,
+				},
+			},
+		},
+		{
+			// Deposit with destination datum hash and alternate
+			cborHex: "d8799f411fd8799fd8799f" + testPlutusAddressBaseHex + "d8799f5820ddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddffffd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffff1a002625a0d87b9fd87a9fd8799f0a14ffffffff",
+			expectedObj: models.SundaeSwapV1EscrowDatum{
+				Ident: models.SundaeSwapIdent{0x1f},
+				Addresses: models.SundaeSwapV1OrderAddresses{
+					Destination: models.SundaeSwapV1Destination{
+						Address:   testPlutusAddressBase,
+						DatumHash: decodeHex("dddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddd"),
+					},
+					Alternate: decodeHex("1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5"),
+				},
+				ScooperFee: 2500000,
+				Action: models.SundaeSwapV1Action{
+					Type:    models.SundaeSwapV1ActionDeposit,
+					AmountA: 10,
+					AmountB: 20,
+				},
+			},
+		},
+		{
+			// Single asset deposit of asset B
+			cborHex: "d8799f411fd8799fd8799f" + testPlutusAddressBaseHex + "d87a80ffd87a80ff1a002625a0d87b9fd8799fd87a801a05f5e100ffffff",
+			expectedObj: models.SundaeSwapV1EscrowDatum{
+				Ident: models.SundaeSwapIdent{0x1f},
+				Addresses: models.SundaeSwapV1OrderAddresses{
+					Destination: models.SundaeSwapV1Destination{
+						Address: testPlutusAddressBase,
+					},
+				},
+				ScooperFee: 2500000,
+				Action: models.SundaeSwapV1Action{
+					Type:          models.SundaeSwapV1ActionDeposit,
+					DepositSingle: true,
+					CoinB:         true,
+					Amount:        100000000,
+				},
+			},
+		},
+		{
+			// Withdraw
+			cborHex: "d8799f411fd8799fd8799f" + testPlutusAddressBaseHex + "d87a80ffd87a80ff1a002625a0d87a9f190309ffff",
+			expectedObj: models.SundaeSwapV1EscrowDatum{
+				Ident: models.SundaeSwapIdent{0x1f},
+				Addresses: models.SundaeSwapV1OrderAddresses{
+					Destination: models.SundaeSwapV1Destination{
+						Address: testPlutusAddressBase,
+					},
+				},
+				ScooperFee: 2500000,
+				Action: models.SundaeSwapV1Action{
+					Type:     models.SundaeSwapV1ActionWithdraw,
+					LpAmount: 777,
+				},
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.SundaeSwapV1EscrowDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+var testSundaeSwapV3Ident = models.SundaeSwapIdent(decodeHex("ba228444515fbefd2c8725338e49589f206c7f18a33e002b157aac3c"))
+
+var testSundaeSwapV3Owner = models.SundaeSwapV3MultisigScript{
+	Type: models.SundaeSwapV3MultisigTypeSignature,
+	Hash: decodeHex("1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5"),
+}
+
+func TestSundaeSwapV3PoolDatumDecodeEncode(t *testing.T) {
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.SundaeSwapV3PoolDatum
+	}{
+		{
+			cborHex: "d8799f581cba228444515fbefd2c8725338e49589f206c7f18a33e002b157aac3c9f9f4040ff9f581c9a9693a9a37912a5097918f97918d15240c92ab729a0b7c4aa144d774653554e444145ffff1b000000024cb016ea181e1832d87a80001a002dc6c0ff",
+			expectedObj: models.SundaeSwapV3PoolDatum{
+				Ident:                testSundaeSwapV3Ident,
+				AssetA:               testLovelaceAsset,
+				AssetB:               testSundaeAsset,
+				CirculatingLp:        9876543210,
+				BidFeesPer10Thousand: 30,
+				AskFeesPer10Thousand: 50,
+				ProtocolFees:         3000000,
+			},
+		},
+		{
+			cborHex: "d8799f581cba228444515fbefd2c8725338e49589f206c7f18a33e002b157aac3c9f9f4040ff9f581c9a9693a9a37912a5097918f97918d15240c92ab729a0b7c4aa144d774653554e444145ffff1b000000024cb016ea181e1832d8799fd87c9f019fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd87e9f1b000001941f297c00ffffffff1b000001941f297c001a002dc6c0ff",
+			expectedObj: models.SundaeSwapV3PoolDatum{
+				Ident:                testSundaeSwapV3Ident,
+				AssetA:               testLovelaceAsset,
+				AssetB:               testSundaeAsset,
+				CirculatingLp:        9876543210,
+				BidFeesPer10Thousand: 30,
+				AskFeesPer10Thousand: 50,
+				FeeManager: &models.SundaeSwapV3MultisigScript{
+					Type:     models.SundaeSwapV3MultisigTypeAtLeast,
+					Required: 1,
+					Scripts: []models.SundaeSwapV3MultisigScript{
+						testSundaeSwapV3Owner,
+						{
+							Type: models.SundaeSwapV3MultisigTypeAfter,
+							Time: 1735689600000,
+						},
+					},
+				},
+				MarketOpen:   1735689600000,
+				ProtocolFees: 3000000,
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.SundaeSwapV3PoolDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+func TestSundaeSwapV3OrderDatumDecodeEncode(t *testing.T) {
+	orderPrefixHex := "d8799fd8799f581cba228444515fbefd2c8725338e49589f206c7f18a33e002b157aac3cffd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ff1a000f4240d8799f" + testPlutusAddressBaseHex
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.SundaeSwapV3OrderDatum
+	}{
+		{
+			// Swap
+			cborHex: orderPrefixHex + "d87980ffd87a9f9f40401a02faf080ff9f581c9a9693a9a37912a5097918f97918d15240c92ab729a0b7c4aa144d774653554e4441451904d2ffffd87980ff",
+			expectedObj: models.SundaeSwapV3OrderDatum{
+				Ident:          testSundaeSwapV3Ident,
+				Owner:          testSundaeSwapV3Owner,
+				MaxProtocolFee: 1000000,
+				Destination: models.SundaeSwapV3Destination{
+					Address: testPlutusAddressBase,
+				},
+				Details: models.SundaeSwapV3OrderDetails{
+					Type: models.SundaeSwapV3OrderSwap,
+					AssetA: models.SundaeSwapV3SingletonValue{
+						PolicyId:  []byte{},
+						AssetName: []byte{},
+						Amount:    50000000,
+					},
+					AssetB: models.SundaeSwapV3SingletonValue{
+						PolicyId:  testSundaeAsset.PolicyId,
+						AssetName: testSundaeAsset.AssetName,
+						Amount:    1234,
+					},
+				},
+				Extension: cbor.RawMessage(decodeHex("d87980")),
+			},
+		},
+		{
+			// Deposit to any pool, self destination
+			cborHex: "d8799fd87a80d8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ff1a000f4240d87a80d87b9f9f9f40401a02faf080ff9f581c9a9693a9a37912a5097918f97918d15240c92ab729a0b7c4aa144d774653554e4441451904d2ffffffd87980ff",
+			expectedObj: models.SundaeSwapV3OrderDatum{
+				Owner:          testSundaeSwapV3Owner,
+				MaxProtocolFee: 1000000,
+				Destination: models.SundaeSwapV3Destination{
+					SelfDestination: true,
+				},
+				Details: models.SundaeSwapV3OrderDetails{
+					Type: models.SundaeSwapV3OrderDeposit,
+					AssetA: models.SundaeSwapV3SingletonValue{
+						PolicyId:  []byte{},
+						AssetName: []byte{},
+						Amount:    50000000,
+					},
+					AssetB: models.SundaeSwapV3SingletonValue{
+						PolicyId:  testSundaeAsset.PolicyId,
+						AssetName: testSundaeAsset.AssetName,
+						Amount:    1234,
+					},
+				},
+				Extension: cbor.RawMessage(decodeHex("d87980")),
+			},
+		},
+		{
+			// Strategy with inline datum destination
+			cborHex: orderPrefixHex + "d87b9fd8799f426869ffffffd8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffffd87980ff",
+			expectedObj: models.SundaeSwapV3OrderDatum{
+				Ident:          testSundaeSwapV3Ident,
+				Owner:          testSundaeSwapV3Owner,
+				MaxProtocolFee: 1000000,
+				Destination: models.SundaeSwapV3Destination{
+					Address:   testPlutusAddressBase,
+					DatumType: models.SundaeSwapV3DatumInline,
+					Datum:     decodeHex("d8799f426869ff"),
+				},
+				Details: models.SundaeSwapV3OrderDetails{
+					Type:           models.SundaeSwapV3OrderStrategy,
+					StrategySigner: testSundaeSwapV3Owner.Hash,
+				},
+				Extension: cbor.RawMessage(decodeHex("d87980")),
+			},
+		},
+		{
+			// Withdrawal with datum hash destination
+			cborHex: orderPrefixHex + "d87a9f5820eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeffffd87c9f9f581caaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa581cba228444515fbefd2c8725338e49589f206c7f18a33e002b157aac3c1903e7ffffd87980ff",
+			expectedObj: models.SundaeSwapV3OrderDatum{
+				Ident:          testSundaeSwapV3Ident,
+				Owner:          testSundaeSwapV3Owner,
+				MaxProtocolFee: 1000000,
+				Destination: models.SundaeSwapV3Destination{
+					Address:   testPlutusAddressBase,
+					DatumType: models.SundaeSwapV3DatumHash,
+					Datum:     decodeHex("eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"),
+				},
+				Details: models.SundaeSwapV3OrderDetails{
+					Type: models.SundaeSwapV3OrderWithdrawal,
+					AssetA: models.SundaeSwapV3SingletonValue{
+						PolicyId:  decodeHex("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+						AssetName: testSundaeSwapV3Ident,
+						Amount:    999,
+					},
+				},
+				Extension: cbor.RawMessage(decodeHex("d87980")),
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.SundaeSwapV3OrderDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+func TestSundaeSwapV3SettingsDatumDecodeEncode(t *testing.T) {
+	treasuryAddress := models.PlutusAddress{
+		PaymentCredential: testPlutusAddressScript.PaymentCredential,
+	}
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.SundaeSwapV3SettingsDatum
+	}{
+		{
+			cborHex: "d8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ff" + testPlutusAddressBaseHex + "d87a9f9fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd87f9f581cbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbffffffd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ff9f18641903e8ffd8799f9f581cccccccccccccccccccccccccccccccccccccccccccccccccccccccccffff9fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffff1a000510e01a000290401a00030d4000d87980ff",
+			expectedObj: models.SundaeSwapV3SettingsDatum{
+				SettingsAdmin: testSundaeSwapV3Owner,
+				MetadataAdmin: testPlutusAddressBase,
+				TreasuryAdmin: models.SundaeSwapV3MultisigScript{
+					Type: models.SundaeSwapV3MultisigTypeAllOf,
+					Scripts: []models.SundaeSwapV3MultisigScript{
+						testSundaeSwapV3Owner,
+						{
+							Type: models.SundaeSwapV3MultisigTypeScript,
+							Hash: decodeHex("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						},
+					},
+				},
+				TreasuryAddress:   treasuryAddress,
+				TreasuryAllowance: [2]int64{100, 1000},
+				AuthorizedScoopers: [][]byte{
+					decodeHex("cccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+				},
+				AuthorizedStakingKeys: []models.PlutusCredential{
+					testPlutusAddressBase.PaymentCredential,
+				},
+				BaseFee:         332000,
+				SimpleFee:       168000,
+				StrategyFee:     200000,
+				PoolCreationFee: 0,
+				Extensions:      cbor.RawMessage(decodeHex("d87980")),
+			},
+		},
+		{
+			cborHex: "d8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ff" + testPlutusAddressBaseHex + "d8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ff9f18641903e8ffd87a80801a000510e01a000290401a00030d4000d87980ff",
+			expectedObj: models.SundaeSwapV3SettingsDatum{
+				SettingsAdmin:         testSundaeSwapV3Owner,
+				MetadataAdmin:         testPlutusAddressBase,
+				TreasuryAdmin:         testSundaeSwapV3Owner,
+				TreasuryAddress:       treasuryAddress,
+				TreasuryAllowance:     [2]int64{100, 1000},
+				AuthorizedStakingKeys: []models.PlutusCredential{},
+				BaseFee:               332000,
+				SimpleFee:             168000,
+				StrategyFee:           200000,
+				PoolCreationFee:       0,
+				Extensions:            cbor.RawMessage(decodeHex("d87980")),
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.SundaeSwapV3SettingsDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+func TestSundaeSwapV3UnexpectedConstructor(t *testing.T) {
+	// Destination with an unknown datum constructor
+	_, err := models.DecodeHex[models.SundaeSwapV3Destination](
+		"d8799f" + testPlutusAddressBaseHex + "d87c80ff",
+	)
+	if !errors.Is(err, models.ErrUnexpectedConstructor) {
+		t.Fatalf("did not get expected error: %v", err)
+	}
+	// Strategy order with an unknown authorization constructor
+	_, err = models.DecodeHex[models.SundaeSwapV3OrderDetails](
+		"d8799fd87b9f581c0102030405060708090a0b0c0d0e0f101112131415161718191a1b1cffff",
+	)
+	if !errors.Is(err, models.ErrUnexpectedConstructor) {
+		t.Fatalf("did not get expected error: %v", err)
+	}
+}
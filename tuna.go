@@ -15,9 +15,35 @@
 package models
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/blinklabs-io/gouroboros/cbor"
+	"golang.org/x/crypto/blake2b"
 )
 
+// MiningState is implemented by proof-of-work mining state datum models. The method names differ
+// from the datum field names, since Go doesn't allow a method with the same name as a field
+type MiningState interface {
+	// Height returns the number of the latest block
+	Height() int64
+	// TipHash returns the hash of the latest block
+	TipHash() []byte
+	// Difficulty returns the expected number of hashes needed to mine the next block
+	Difficulty() *big.Int
+	// Timestamp returns the time of the latest block
+	Timestamp() time.Time
+}
+
 // TunaV1State represents the datum format used by the $TUNA mining smart contract (v1)
 type TunaV1State struct {
 	// This allows the type to be used with cbor.DecodeGeneric
@@ -64,6 +90,82 @@ func (t *TunaV1State) UnmarshalCBOR(cborData []byte) error {
 	)
 }
 
+type tunaV1StateJson struct {
+	BlockNumber      int64    `json:"blockNumber"`
+	CurrentHash      string   `json:"currentHash"`
+	LeadingZeros     int64    `json:"leadingZeros"`
+	DifficultyNumber int64    `json:"difficultyNumber"`
+	EpochTime        int64    `json:"epochTime"`
+	RealTimeNow      int64    `json:"realTimeNow"`
+	Extra            string   `json:"extra,omitempty"`
+	Interlink        []string `json:"interlink"`
+}
+
+// MarshalJSON encodes the state with hex-encoded hashes. The extra data is arbitrary Plutus data,
+// so it is output as hex-encoded CBOR
+func (t TunaV1State) MarshalJSON() ([]byte, error) {
+	tmp := tunaV1StateJson{
+		BlockNumber:      t.BlockNumber,
+		CurrentHash:      hex.EncodeToString(t.CurrentHash),
+		LeadingZeros:     t.LeadingZeros,
+		DifficultyNumber: t.DifficultyNumber,
+		EpochTime:        t.EpochTime,
+		RealTimeNow:      t.RealTimeNow,
+		Interlink:        make([]string, 0, len(t.Interlink)),
+	}
+	if t.Extra != nil {
+		extraCbor, err := cbor.Encode(&t.Extra)
+		if err != nil {
+			return nil, err
+		}
+		tmp.Extra = hex.EncodeToString(extraCbor)
+	}
+	for _, item := range t.Interlink {
+		tmp.Interlink = append(tmp.Interlink, hex.EncodeToString(item))
+	}
+	return json.Marshal(&tmp)
+}
+
+func (t *TunaV1State) UnmarshalJSON(data []byte) error {
+	var tmp tunaV1StateJson
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	currentHash, err := hex.DecodeString(tmp.CurrentHash)
+	if err != nil {
+		return fmt.Errorf("invalid current hash: %w: %w", ErrNotHex, err)
+	}
+	var extra any
+	if tmp.Extra != "" {
+		extraCbor, err := hex.DecodeString(tmp.Extra)
+		if err != nil {
+			return fmt.Errorf("invalid extra data: %w: %w", ErrNotHex, err)
+		}
+		if _, err := cbor.Decode(extraCbor, &extra); err != nil {
+			return fmt.Errorf("invalid extra data: %w", err)
+		}
+	}
+	var interlink [][]byte
+	for idx, item := range tmp.Interlink {
+		tmpItem, err := hex.DecodeString(item)
+		if err != nil {
+			return fmt.Errorf("invalid interlink item %d: %w: %w", idx, ErrNotHex, err)
+		}
+		interlink = append(interlink, tmpItem)
+	}
+	*t = TunaV1State{
+		BlockNumber:      tmp.BlockNumber,
+		CurrentHash:      currentHash,
+		LeadingZeros:     tmp.LeadingZeros,
+		DifficultyNumber: tmp.DifficultyNumber,
+		EpochTime:        tmp.EpochTime,
+		RealTimeNow:      tmp.RealTimeNow,
+		Extra:            extra,
+		Interlink:        interlink,
+	}
+	return nil
+}
+
 // TunaV2State represents the datum format used by the $TUNA mining smart contract (v2)
 type TunaV2State struct {
 	// This allows the type to be used with cbor.DecodeGeneric
@@ -103,3 +205,1240 @@ func (t *TunaV2State) UnmarshalCBOR(cborData []byte) error {
 		t,
 	)
 }
+
+type tunaV2StateJson struct {
+	BlockNumber      int64  `json:"blockNumber"`
+	CurrentHash      string `json:"currentHash"`
+	LeadingZeros     int64  `json:"leadingZeros"`
+	DifficultyNumber int64  `json:"difficultyNumber"`
+	EpochTime        int64  `json:"epochTime"`
+	CurrentPosixTime int64  `json:"currentPosixTime"`
+	MerkleRoot       string `json:"merkleRoot"`
+}
+
+// MarshalJSON encodes the state with hex-encoded hashes
+func (t TunaV2State) MarshalJSON() ([]byte, error) {
+	tmp := tunaV2StateJson{
+		BlockNumber:      t.BlockNumber,
+		CurrentHash:      hex.EncodeToString(t.CurrentHash),
+		LeadingZeros:     t.LeadingZeros,
+		DifficultyNumber: t.DifficultyNumber,
+		EpochTime:        t.EpochTime,
+		CurrentPosixTime: t.CurrentPosixTime,
+		MerkleRoot:       hex.EncodeToString(t.MerkleRoot),
+	}
+	return json.Marshal(&tmp)
+}
+
+func (t *TunaV2State) UnmarshalJSON(data []byte) error {
+	var tmp tunaV2StateJson
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	currentHash, err := hex.DecodeString(tmp.CurrentHash)
+	if err != nil {
+		return fmt.Errorf("invalid current hash: %w: %w", ErrNotHex, err)
+	}
+	merkleRoot, err := hex.DecodeString(tmp.MerkleRoot)
+	if err != nil {
+		return fmt.Errorf("invalid merkle root: %w: %w", ErrNotHex, err)
+	}
+	*t = TunaV2State{
+		BlockNumber:      tmp.BlockNumber,
+		CurrentHash:      currentHash,
+		LeadingZeros:     tmp.LeadingZeros,
+		DifficultyNumber: tmp.DifficultyNumber,
+		EpochTime:        tmp.EpochTime,
+		CurrentPosixTime: tmp.CurrentPosixTime,
+		MerkleRoot:       merkleRoot,
+	}
+	return nil
+}
+
+// tunaStateDecoders maps the number of fields in a TUNA state datum to a function that decodes
+// that layout. Support for a new state version is added by adding its layout here
+var tunaStateDecoders = map[int]func([]byte) (MiningState, error){
+	8: func(cborData []byte) (MiningState, error) {
+		var ret TunaV1State
+		if _, err := cbor.Decode(cborData, &ret); err != nil {
+			return nil, err
+		}
+		return &ret, nil
+	},
+	7: func(cborData []byte) (MiningState, error) {
+		var ret TunaV2State
+		if _, err := cbor.Decode(cborData, &ret); err != nil {
+			return nil, err
+		}
+		return &ret, nil
+	},
+}
+
+// DecodeTunaState decodes a TUNA state datum of any supported version, detecting the version from
+// the datum layout. The result is a *TunaV1State or *TunaV2State
+func DecodeTunaState(cborData []byte, opts ...DecodeOption) (MiningState, error) {
+	cfg := newDecodeConfig(opts)
+	if err := cfg.checkInput(cborData); err != nil {
+		return nil, err
+	}
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return nil, err
+	}
+	if constr != 0 {
+		return nil, fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	decodeFunc, ok := tunaStateDecoders[len(fields)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported TUNA state layout with %d fields", len(fields))
+	}
+	ret, err := decodeFunc(cborData)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.checkResult(cborData, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// TunaHardForkLockState represents the datum format used by the $TUNA hard fork contract to
+// track V1 tokens locked for migration to V2
+type TunaHardForkLockState struct {
+	// This allows the type to be used with cbor.DecodeGeneric
+	cbor.StructAsArray
+	// V1 block height at which the lock state was last updated
+	BlockHeight int64
+	// Total amount of V1 $TUNA currently locked in the contract
+	CurrentLockedTuna int64
+}
+
+func (t *TunaHardForkLockState) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			t.BlockHeight,
+			t.CurrentLockedTuna,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (t *TunaHardForkLockState) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &t.BlockHeight, &t.CurrentLockedTuna)
+}
+
+// TunaMinerCredentialType identifies how a miner is identified in the V2 contract
+type TunaMinerCredentialType uint
+
+const (
+	TunaMinerCredentialTypePkh TunaMinerCredentialType = 0
+	TunaMinerCredentialTypeNft TunaMinerCredentialType = 1
+)
+
+// TunaMinerCredential identifies the miner in the $TUNA mining contract (v2). A miner is either a
+// public key hash with arbitrary extra data, or the holder of an NFT in a transaction output
+type TunaMinerCredential struct {
+	Type TunaMinerCredentialType
+	// PubKeyHash and Extra are used with TunaMinerCredentialTypePkh
+	PubKeyHash []byte
+	Extra      any
+	// PolicyId, AssetName and OutputIndex are used with TunaMinerCredentialTypeNft. OutputIndex
+	// is the index of the transaction output holding the NFT
+	PolicyId    []byte
+	AssetName   []byte
+	OutputIndex int64
+}
+
+func (t *TunaMinerCredential) MarshalCBOR() ([]byte, error) {
+	var tmp cbor.Constructor
+	switch t.Type {
+	case TunaMinerCredentialTypePkh:
+		tmp = cbor.NewConstructor(
+			uint(t.Type),
+			cbor.IndefLengthList{
+				t.PubKeyHash,
+				t.Extra,
+			},
+		)
+	case TunaMinerCredentialTypeNft:
+		tmp = cbor.NewConstructor(
+			uint(t.Type),
+			cbor.IndefLengthList{
+				t.PolicyId,
+				t.AssetName,
+				t.OutputIndex,
+			},
+		)
+	default:
+		return nil, fmt.Errorf("unknown miner credential type: %d", t.Type)
+	}
+	return cbor.Encode(&tmp)
+}
+
+func (t *TunaMinerCredential) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	switch TunaMinerCredentialType(constr) {
+	case TunaMinerCredentialTypePkh:
+		t.Type = TunaMinerCredentialType(constr)
+		return decodePlutusFields(fields, &t.PubKeyHash, &t.Extra)
+	case TunaMinerCredentialTypeNft:
+		t.Type = TunaMinerCredentialType(constr)
+		return decodePlutusFields(fields, &t.PolicyId, &t.AssetName, &t.OutputIndex)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// PayoutCredential returns the payment credential that mining rewards are paid to. This is only
+// available for public key hash miners, since rewards for NFT miners go to the holder of the NFT
+func (t TunaMinerCredential) PayoutCredential() (PlutusCredential, error) {
+	if t.Type != TunaMinerCredentialTypePkh {
+		return PlutusCredential{}, fmt.Errorf("no payout credential for miner credential type: %d", t.Type)
+	}
+	return PlutusCredential{
+		Type: PlutusCredentialTypePubKey,
+		Hash: t.PubKeyHash,
+	}, nil
+}
+
+// NftAsset returns the asset class of the NFT identifying an NFT miner
+func (t TunaMinerCredential) NftAsset() (PlutusAssetClass, error) {
+	if t.Type != TunaMinerCredentialTypeNft {
+		return PlutusAssetClass{}, fmt.Errorf("no NFT for miner credential type: %d", t.Type)
+	}
+	return PlutusAssetClass{
+		PolicyId:  t.PolicyId,
+		AssetName: t.AssetName,
+	}, nil
+}
+
+const (
+	// TunaEpochNumber is the number of blocks between difficulty adjustments
+	TunaEpochNumber = 2016
+	// TunaEpochTarget is the target duration of an epoch, in milliseconds
+	TunaEpochTarget = 1_209_600_000
+	// Minimum and maximum number of leading zeros in the target hash
+	TunaMinLeadingZeros = 2
+	TunaMaxLeadingZeros = 62
+	// Used to keep precision when adjusting the difficulty number
+	tunaDifficultyPadding = 16
+)
+
+// TunaTarget returns the target for the provided leading zeros and difficulty number as a
+// 256-bit integer. The leading zeros are counted in hex digits, and the difficulty number is the
+// 16-bit value that follows them. With more than 60 leading zeros, the difficulty number extends
+// past the end of the hash, so only its leading digits are part of the target
+func TunaTarget(leadingZeros int64, difficultyNumber int64) *big.Int {
+	ret := big.NewInt(difficultyNumber)
+	shift := 256 - 16 - 4*leadingZeros
+	if shift < 0 {
+		return ret.Rsh(ret, uint(-shift))
+	}
+	return ret.Lsh(ret, uint(shift))
+}
+
+// TunaHashDifficulty returns the number of leading zero hex digits in the hash, along with the
+// 16-bit value of the 4 hex digits that follow them
+func TunaHashDifficulty(hash []byte) (int64, int64) {
+	hexHash := hex.EncodeToString(hash)
+	trimmed := strings.TrimLeft(hexHash, "0")
+	leadingZeros := int64(len(hexHash) - len(trimmed))
+	// Pad the hash, in case it ends in fewer than 4 digits after the zeros
+	trimmed += "0000"
+	difficultyNumber, _ := strconv.ParseInt(trimmed[:4], 16, 64)
+	return leadingZeros, difficultyNumber
+}
+
+// TunaMeetsTarget returns true if the hash has more leading zeros than required, or the same
+// number of leading zeros and a lower difficulty number, as checked by the validator
+func TunaMeetsTarget(hash []byte, leadingZeros int64, difficultyNumber int64) bool {
+	hashLeadingZeros, hashDifficultyNumber := TunaHashDifficulty(hash)
+	if hashLeadingZeros > leadingZeros {
+		return true
+	}
+	return hashLeadingZeros == leadingZeros && hashDifficultyNumber < difficultyNumber
+}
+
+// TunaDifficultyAdjustment returns the ratio to adjust the difficulty number by, given the total
+// time taken for the last epoch in milliseconds. The adjustment is limited to a factor of 4
+func TunaDifficultyAdjustment(totalEpochTime int64) (int64, int64) {
+	// Treat a non-positive epoch time as the fastest possible epoch
+	if totalEpochTime <= 0 {
+		return 1, 4
+	}
+	if TunaEpochTarget/totalEpochTime >= 4 && TunaEpochTarget%totalEpochTime > 0 {
+		return 1, 4
+	}
+	if totalEpochTime/TunaEpochTarget >= 4 && totalEpochTime%TunaEpochTarget > 0 {
+		return 4, 1
+	}
+	return totalEpochTime, TunaEpochTarget
+}
+
+// TunaNextDifficulty applies a difficulty adjustment ratio to the difficulty number, moving to
+// more or fewer leading zeros as needed to keep the difficulty number within 16 bits. It returns
+// the new difficulty number and leading zeros
+func TunaNextDifficulty(
+	difficultyNumber int64,
+	leadingZeros int64,
+	adjustmentNumerator int64,
+	adjustmentDenominator int64,
+) (int64, int64) {
+	newPaddedDifficulty := difficultyNumber * tunaDifficultyPadding * adjustmentNumerator / adjustmentDenominator
+	newDifficulty := newPaddedDifficulty / tunaDifficultyPadding
+	if newPaddedDifficulty/65536 == 0 {
+		if leadingZeros >= TunaMaxLeadingZeros {
+			return 4096, TunaMaxLeadingZeros
+		}
+		return newPaddedDifficulty, leadingZeros + 1
+	}
+	if newDifficulty/65536 > 0 {
+		if leadingZeros <= TunaMinLeadingZeros {
+			return 65535, TunaMinLeadingZeros
+		}
+		return newDifficulty / tunaDifficultyPadding, leadingZeros - 1
+	}
+	return newDifficulty, leadingZeros
+}
+
+// TunaInterlink returns the updated interlink after adding a block with the provided hash, given
+// the leading zeros and difficulty number of the state that the block was mined against. Each
+// interlink level holds the latest block that is at least twice as hard as the level below, with
+// the first level being twice as hard as the target
+func TunaInterlink(interlink [][]byte, blockHash []byte, leadingZeros int64, difficultyNumber int64) [][]byte {
+	ret := make([][]byte, len(interlink))
+	copy(ret, interlink)
+	hashLeadingZeros, hashDifficultyNumber := TunaHashDifficulty(blockHash)
+	levelLeadingZeros, levelDifficultyNumber := tunaHalveDifficulty(leadingZeros, difficultyNumber)
+	// The level difficulty can't exceed the hash length, which also guards against an all-zero hash
+	for idx := 0; levelLeadingZeros < 64; idx++ {
+		harder := hashLeadingZeros > levelLeadingZeros ||
+			(hashLeadingZeros == levelLeadingZeros && hashDifficultyNumber < levelDifficultyNumber)
+		if !harder {
+			break
+		}
+		if idx < len(ret) {
+			ret[idx] = blockHash
+		} else {
+			ret = append(ret, blockHash)
+		}
+		levelLeadingZeros, levelDifficultyNumber = tunaHalveDifficulty(levelLeadingZeros, levelDifficultyNumber)
+	}
+	return ret
+}
+
+// tunaHalveDifficulty returns the leading zeros and difficulty number for half of the provided
+// target, moving to one more leading zero when the difficulty number drops below 4 hex digits
+func tunaHalveDifficulty(leadingZeros int64, difficultyNumber int64) (int64, int64) {
+	newDifficultyNumber := difficultyNumber / 2
+	if newDifficultyNumber < 4096 {
+		return leadingZeros + 1, newDifficultyNumber * 16
+	}
+	return leadingZeros, newDifficultyNumber
+}
+
+// NextInterlink returns the interlink for the successor state after mining a block with the
+// provided hash
+func (t TunaV1State) NextInterlink(blockHash []byte) [][]byte {
+	return TunaInterlink(t.Interlink, blockHash, t.LeadingZeros, t.DifficultyNumber)
+}
+
+// Target returns the current target for the state
+func (t TunaV1State) Target() *big.Int {
+	return TunaTarget(t.LeadingZeros, t.DifficultyNumber)
+}
+
+// MeetsTarget returns true if the hash meets the current target for the state
+func (t TunaV1State) MeetsTarget(hash []byte) bool {
+	return TunaMeetsTarget(hash, t.LeadingZeros, t.DifficultyNumber)
+}
+
+// Target returns the current target for the state
+func (t TunaV2State) Target() *big.Int {
+	return TunaTarget(t.LeadingZeros, t.DifficultyNumber)
+}
+
+// MeetsTarget returns true if the hash meets the current target for the state
+func (t TunaV2State) MeetsTarget(hash []byte) bool {
+	return TunaMeetsTarget(hash, t.LeadingZeros, t.DifficultyNumber)
+}
+
+// Earliest plausible POSIX time (in milliseconds) for a TUNA state, which is the start of 2023,
+// before the mining contract launched
+const tunaMinPosixTime = 1_672_531_200_000
+
+func (t TunaV1State) modelVersion() int {
+	return 1
+}
+
+func (t TunaV2State) modelVersion() int {
+	return 2
+}
+
+func (t TunaV1State) Height() int64 {
+	return t.BlockNumber
+}
+
+func (t TunaV1State) TipHash() []byte {
+	return t.CurrentHash
+}
+
+func (t TunaV1State) Difficulty() *big.Int {
+	return tunaDifficulty(t.Target())
+}
+
+func (t TunaV1State) Timestamp() time.Time {
+	return time.UnixMilli(t.RealTimeNow)
+}
+
+func (t TunaV2State) Height() int64 {
+	return t.BlockNumber
+}
+
+func (t TunaV2State) TipHash() []byte {
+	return t.CurrentHash
+}
+
+func (t TunaV2State) Difficulty() *big.Int {
+	return tunaDifficulty(t.Target())
+}
+
+func (t TunaV2State) Timestamp() time.Time {
+	return time.UnixMilli(t.CurrentPosixTime)
+}
+
+// tunaDifficulty returns the expected number of hashes needed to find one below the target
+func tunaDifficulty(target *big.Int) *big.Int {
+	if target.Sign() <= 0 {
+		return new(big.Int)
+	}
+	ret := new(big.Int).Lsh(big.NewInt(1), 256)
+	return ret.Div(ret, target)
+}
+
+// TunaMetrics contains metrics derived from a TUNA state datum, in a flat form suitable for
+// monitoring systems. Rates are estimated from the blocks mined so far in the current epoch
+type TunaMetrics struct {
+	BlockNumber      int64 `json:"blockNumber"`
+	LeadingZeros     int64 `json:"leadingZeros"`
+	DifficultyNumber int64 `json:"difficultyNumber"`
+	// Difficulty is the expected number of hashes needed to mine a block
+	Difficulty float64 `json:"difficulty"`
+	// EpochBlocks is the number of blocks mined so far in the current epoch
+	EpochBlocks int64 `json:"epochBlocks"`
+	// EpochSeconds is the time taken by the blocks mined so far in the current epoch
+	EpochSeconds float64 `json:"epochSeconds"`
+	// AverageBlockSeconds is the average time between blocks in the current epoch
+	AverageBlockSeconds float64 `json:"averageBlockSeconds"`
+	BlocksPerHour       float64 `json:"blocksPerHour"`
+	// HashRate is the estimated network hash rate, in hashes per second
+	HashRate float64 `json:"hashRate"`
+	// Timestamp is the POSIX time of the latest block, in milliseconds
+	Timestamp int64 `json:"timestamp"`
+}
+
+// Metrics returns metrics derived from the state
+func (t TunaV1State) Metrics() TunaMetrics {
+	return newTunaMetrics(t, t.LeadingZeros, t.DifficultyNumber, t.EpochTime)
+}
+
+// Metrics returns metrics derived from the state
+func (t TunaV2State) Metrics() TunaMetrics {
+	return newTunaMetrics(t, t.LeadingZeros, t.DifficultyNumber, t.EpochTime)
+}
+
+func newTunaMetrics(state MiningState, leadingZeros int64, difficultyNumber int64, epochTime int64) TunaMetrics {
+	difficulty, _ := new(big.Float).SetInt(state.Difficulty()).Float64()
+	ret := TunaMetrics{
+		BlockNumber:      state.Height(),
+		LeadingZeros:     leadingZeros,
+		DifficultyNumber: difficultyNumber,
+		Difficulty:       difficulty,
+		EpochBlocks:      tunaEpochBlocks(state.Height()),
+		EpochSeconds:     float64(epochTime) / 1000,
+		Timestamp:        state.Timestamp().UnixMilli(),
+	}
+	if ret.EpochBlocks > 0 && epochTime > 0 {
+		ret.AverageBlockSeconds = ret.EpochSeconds / float64(ret.EpochBlocks)
+		ret.BlocksPerHour = 3600 / ret.AverageBlockSeconds
+		ret.HashRate = difficulty / ret.AverageBlockSeconds
+	}
+	return ret
+}
+
+func (t TunaV1State) String() string {
+	return fmt.Sprintf(
+		"TunaV1State { BlockNumber = %d, CurrentHash = %x, LeadingZeros = %d, DifficultyNumber = %d, EpochTime = %d, RealTimeNow = %d }",
+		t.BlockNumber,
+		t.CurrentHash,
+		t.LeadingZeros,
+		t.DifficultyNumber,
+		t.EpochTime,
+		t.RealTimeNow,
+	)
+}
+
+func (t TunaV2State) String() string {
+	return fmt.Sprintf(
+		"TunaV2State { BlockNumber = %d, CurrentHash = %x, LeadingZeros = %d, DifficultyNumber = %d, EpochTime = %d, CurrentPosixTime = %d, MerkleRoot = %x }",
+		t.BlockNumber,
+		t.CurrentHash,
+		t.LeadingZeros,
+		t.DifficultyNumber,
+		t.EpochTime,
+		t.CurrentPosixTime,
+		t.MerkleRoot,
+	)
+}
+
+// LogValue summarizes the state for structured logging
+func (t TunaV1State) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int64("blockNumber", t.BlockNumber),
+		slog.String("currentHash", hex.EncodeToString(t.CurrentHash)),
+		slog.Int64("leadingZeros", t.LeadingZeros),
+		slog.Int64("difficultyNumber", t.DifficultyNumber),
+		slog.Int("interlinkLen", len(t.Interlink)),
+	)
+}
+
+// LogValue summarizes the state for structured logging
+func (t TunaV2State) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int64("blockNumber", t.BlockNumber),
+		slog.String("currentHash", hex.EncodeToString(t.CurrentHash)),
+		slog.Int64("leadingZeros", t.LeadingZeros),
+		slog.Int64("difficultyNumber", t.DifficultyNumber),
+		slog.String("merkleRoot", hex.EncodeToString(t.MerkleRoot)),
+	)
+}
+
+// tunaEpochBlocks returns the number of block intervals included in the epoch time of a state
+// with the provided block number. The epoch time restarts in the block after the one that
+// completes an epoch, except for the first epoch, which starts at the genesis block
+func tunaEpochBlocks(blockNumber int64) int64 {
+	if blockNumber <= TunaEpochNumber {
+		return blockNumber
+	}
+	return (blockNumber - 1) % TunaEpochNumber
+}
+
+// BlockHash returns the hash of a block mined with the provided nonce against the state. This is
+// the double SHA-256 hash of the target state, which is a Plutus constructor with the nonce, block
+// number, current hash, leading zeros, difficulty number and epoch time
+func (t TunaV1State) BlockHash(nonce []byte) ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			nonce,
+			t.BlockNumber,
+			t.CurrentHash,
+			t.LeadingZeros,
+			t.DifficultyNumber,
+			t.EpochTime,
+		},
+	)
+	return tunaDoubleSha256(&tmp)
+}
+
+// Next returns the successor state after mining a block with the provided nonce. The time is the
+// POSIX time in milliseconds used by the validator, which is the midpoint of the transaction
+// validity interval. An error is returned if the block hash doesn't meet the current target
+func (t TunaV1State) Next(nonce []byte, now int64) (TunaV1State, error) {
+	blockHash, err := t.BlockHash(nonce)
+	if err != nil {
+		return TunaV1State{}, err
+	}
+	if !t.MeetsTarget(blockHash) {
+		return TunaV1State{}, fmt.Errorf("block hash does not meet target: %x", blockHash)
+	}
+	leadingZeros, difficultyNumber, epochTime := tunaNextDifficultyState(
+		t.BlockNumber,
+		t.LeadingZeros,
+		t.DifficultyNumber,
+		t.EpochTime,
+		t.RealTimeNow,
+		now,
+	)
+	return TunaV1State{
+		BlockNumber:      t.BlockNumber + 1,
+		CurrentHash:      blockHash,
+		LeadingZeros:     leadingZeros,
+		DifficultyNumber: difficultyNumber,
+		EpochTime:        epochTime,
+		RealTimeNow:      now,
+		Extra:            t.Extra,
+		Interlink:        t.NextInterlink(blockHash),
+	}, nil
+}
+
+// BlockHash returns the hash of a block mined by the provided miner with the provided nonce
+// against the state. This is the double SHA-256 hash of the target state, which is a Plutus
+// constructor with the nonce, miner credential, block number, current hash, leading zeros,
+// difficulty number and epoch time
+func (t TunaV2State) BlockHash(nonce []byte, miner TunaMinerCredential) ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			nonce,
+			&miner,
+			t.BlockNumber,
+			t.CurrentHash,
+			t.LeadingZeros,
+			t.DifficultyNumber,
+			t.EpochTime,
+		},
+	)
+	return tunaDoubleSha256(&tmp)
+}
+
+// Next returns the successor state after the provided miner mines a block with the provided
+// nonce. The block hash is inserted into the provided trie, which must match the current Merkle
+// root. The time is the POSIX time in milliseconds used by the validator, which is the midpoint
+// of the transaction validity interval. An error is returned if the block hash doesn't meet the
+// current target
+func (t TunaV2State) Next(
+	nonce []byte,
+	miner TunaMinerCredential,
+	now int64,
+	tree *TunaMerkleTree,
+) (TunaV2State, error) {
+	if !bytes.Equal(tree.Root(), t.MerkleRoot) {
+		return TunaV2State{}, errors.New("trie does not match the current merkle root")
+	}
+	blockHash, err := t.BlockHash(nonce, miner)
+	if err != nil {
+		return TunaV2State{}, err
+	}
+	if !t.MeetsTarget(blockHash) {
+		return TunaV2State{}, fmt.Errorf("block hash does not meet target: %x", blockHash)
+	}
+	if err := tree.Insert(blockHash, blockHash); err != nil {
+		return TunaV2State{}, err
+	}
+	leadingZeros, difficultyNumber, epochTime := tunaNextDifficultyState(
+		t.BlockNumber,
+		t.LeadingZeros,
+		t.DifficultyNumber,
+		t.EpochTime,
+		t.CurrentPosixTime,
+		now,
+	)
+	return TunaV2State{
+		BlockNumber:      t.BlockNumber + 1,
+		CurrentHash:      blockHash,
+		LeadingZeros:     leadingZeros,
+		DifficultyNumber: difficultyNumber,
+		EpochTime:        epochTime,
+		CurrentPosixTime: now,
+		MerkleRoot:       tree.Root(),
+	}, nil
+}
+
+// tunaNextDifficultyState returns the leading zeros, difficulty number and epoch time for the
+// successor state. The difficulty is adjusted when the current block completes an epoch, after
+// which the epoch time restarts
+func tunaNextDifficultyState(
+	blockNumber int64,
+	leadingZeros int64,
+	difficultyNumber int64,
+	epochTime int64,
+	prevTime int64,
+	now int64,
+) (int64, int64, int64) {
+	epochTime += now - prevTime
+	if blockNumber > 0 && blockNumber%TunaEpochNumber == 0 {
+		numerator, denominator := TunaDifficultyAdjustment(epochTime)
+		difficultyNumber, leadingZeros = TunaNextDifficulty(
+			difficultyNumber,
+			leadingZeros,
+			numerator,
+			denominator,
+		)
+		epochTime = 0
+	}
+	return leadingZeros, difficultyNumber, epochTime
+}
+
+func tunaDoubleSha256(v any) ([]byte, error) {
+	cborData, err := cbor.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(cborData)
+	hash = sha256.Sum256(hash[:])
+	return hash[:], nil
+}
+
+// NewTunaV2StateFromV1 returns the initial V2 state for the hard fork from the final V1 state. The
+// block number, current hash, difficulty and timing carry over, the interlink is dropped, and the
+// Merkle root is that of a trie containing the final V1 block hash
+func NewTunaV2StateFromV1(v1State TunaV1State) (TunaV2State, error) {
+	if err := v1State.Validate(); err != nil {
+		return TunaV2State{}, err
+	}
+	tree, err := NewTunaMerkleTreeFromBlockHashes([][]byte{v1State.CurrentHash})
+	if err != nil {
+		return TunaV2State{}, err
+	}
+	return TunaV2State{
+		BlockNumber:      v1State.BlockNumber,
+		CurrentHash:      v1State.CurrentHash,
+		LeadingZeros:     v1State.LeadingZeros,
+		DifficultyNumber: v1State.DifficultyNumber,
+		EpochTime:        v1State.EpochTime,
+		CurrentPosixTime: v1State.RealTimeNow,
+		MerkleRoot:       tree.Root(),
+	}, nil
+}
+
+// Validate checks that the state fields are well-formed
+func (t TunaV1State) Validate() error {
+	if err := validateTunaState(
+		t.BlockNumber,
+		t.CurrentHash,
+		t.LeadingZeros,
+		t.DifficultyNumber,
+		t.EpochTime,
+		t.RealTimeNow,
+	); err != nil {
+		return err
+	}
+	for idx, item := range t.Interlink {
+		if len(item) != 32 {
+			return fmt.Errorf("invalid interlink hash length at index %d: %d", idx, len(item))
+		}
+	}
+	return nil
+}
+
+// Validate checks that the state fields are well-formed
+func (t TunaV2State) Validate() error {
+	if err := validateTunaState(
+		t.BlockNumber,
+		t.CurrentHash,
+		t.LeadingZeros,
+		t.DifficultyNumber,
+		t.EpochTime,
+		t.CurrentPosixTime,
+	); err != nil {
+		return err
+	}
+	if len(t.MerkleRoot) != tunaMerkleHashSize {
+		return fmt.Errorf("invalid merkle root length: %d", len(t.MerkleRoot))
+	}
+	return nil
+}
+
+// Validate checks that the lock state fields are well-formed
+func (t TunaHardForkLockState) Validate() error {
+	if t.BlockHeight < 0 {
+		return fmt.Errorf("invalid block height: %d", t.BlockHeight)
+	}
+	if t.CurrentLockedTuna < 0 {
+		return fmt.Errorf("invalid locked amount: %d", t.CurrentLockedTuna)
+	}
+	return nil
+}
+
+func validateTunaState(
+	blockNumber int64,
+	currentHash []byte,
+	leadingZeros int64,
+	difficultyNumber int64,
+	epochTime int64,
+	posixTime int64,
+) error {
+	if blockNumber < 0 {
+		return fmt.Errorf("invalid block number: %d", blockNumber)
+	}
+	if len(currentHash) != 32 {
+		return fmt.Errorf("invalid current hash length: %d", len(currentHash))
+	}
+	if leadingZeros < TunaMinLeadingZeros || leadingZeros > TunaMaxLeadingZeros {
+		return fmt.Errorf("leading zeros out of range: %d", leadingZeros)
+	}
+	if difficultyNumber <= 0 || difficultyNumber > 65535 {
+		return fmt.Errorf("difficulty number out of range: %d", difficultyNumber)
+	}
+	if epochTime < 0 {
+		return fmt.Errorf("invalid epoch time: %d", epochTime)
+	}
+	if posixTime < tunaMinPosixTime {
+		return fmt.Errorf("implausible POSIX time: %d", posixTime)
+	}
+	return nil
+}
+
+// Size of the hashes used in the Merkle Patricia Forestry trie
+const tunaMerkleHashSize = 32
+
+// Hash of an empty trie or subtree
+var tunaMerkleNullHash = make([]byte, tunaMerkleHashSize)
+
+// TunaMerkleTree is a Merkle Patricia Forestry trie, as used for TunaV2State.MerkleRoot. Keys are
+// hashed with blake2b-256 to give the path through the trie, and values are stored by their
+// blake2b-256 hash
+type TunaMerkleTree struct {
+	// Value hashes, keyed by the path (key hash)
+	entries map[string][]byte
+}
+
+func NewTunaMerkleTree() *TunaMerkleTree {
+	return &TunaMerkleTree{
+		entries: make(map[string][]byte),
+	}
+}
+
+// NewTunaMerkleTreeFromBlockHashes returns a trie containing the provided block hashes. Each block
+// hash is used as both the key and the value
+func NewTunaMerkleTreeFromBlockHashes(blockHashes [][]byte) (*TunaMerkleTree, error) {
+	ret := NewTunaMerkleTree()
+	for _, blockHash := range blockHashes {
+		if err := ret.Insert(blockHash, blockHash); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// Insert adds a key and value to the trie. Keys can only be inserted once
+func (t *TunaMerkleTree) Insert(key []byte, value []byte) error {
+	path := string(tunaMerkleHash(key))
+	if _, ok := t.entries[path]; ok {
+		return fmt.Errorf("key already exists in trie: %x", key)
+	}
+	t.entries[path] = tunaMerkleHash(value)
+	return nil
+}
+
+// Has returns true if the trie contains the key
+func (t *TunaMerkleTree) Has(key []byte) bool {
+	_, ok := t.entries[string(tunaMerkleHash(key))]
+	return ok
+}
+
+// Len returns the number of entries in the trie
+func (t *TunaMerkleTree) Len() int {
+	return len(t.entries)
+}
+
+// Root returns the root hash of the trie. The root of an empty trie is all zeros
+func (t *TunaMerkleTree) Root() []byte {
+	return t.nodeHash(t.paths(), 0)
+}
+
+// Prove returns a proof that the key is a member of the trie
+func (t *TunaMerkleTree) Prove(key []byte) (TunaMerkleProof, error) {
+	path := string(tunaMerkleHash(key))
+	if _, ok := t.entries[path]; !ok {
+		return nil, fmt.Errorf("key not found in trie: %x", key)
+	}
+	ret := TunaMerkleProof{}
+	paths := t.paths()
+	cursor := 0
+	for len(paths) > 1 {
+		prefixLen := tunaMerkleCommonPrefixLen(paths, cursor)
+		branchCursor := cursor + prefixLen
+		groups := tunaMerkleGroupPaths(paths, branchCursor)
+		branch := tunaMerkleNibble([]byte(path), branchCursor)
+		var neighborNibbles []int
+		for nibble, group := range groups {
+			if nibble != branch && len(group) > 0 {
+				neighborNibbles = append(neighborNibbles, nibble)
+			}
+		}
+		step := TunaMerkleProofStep{
+			Skip: int64(prefixLen),
+		}
+		if len(neighborNibbles) == 1 {
+			// A single neighbor is included directly, rather than as neighbor hashes
+			neighborNibble := neighborNibbles[0]
+			neighborPaths := groups[neighborNibble]
+			if len(neighborPaths) == 1 {
+				step.Type = TunaMerkleProofStepTypeLeaf
+				step.Key = []byte(neighborPaths[0])
+				step.Value = t.entries[neighborPaths[0]]
+			} else {
+				neighborCursor := branchCursor + 1
+				neighborPrefixLen := tunaMerkleCommonPrefixLen(neighborPaths, neighborCursor)
+				step.Type = TunaMerkleProofStepTypeFork
+				step.Neighbor = TunaMerkleNeighbor{
+					Nibble: int64(neighborNibble),
+					Prefix: tunaMerkleNibbles(
+						[]byte(neighborPaths[0]),
+						neighborCursor,
+						neighborCursor+neighborPrefixLen,
+					),
+					Root: tunaMerkleRoot(t.childHashes(neighborPaths, neighborCursor+neighborPrefixLen)),
+				}
+			}
+		} else {
+			step.Type = TunaMerkleProofStepTypeBranch
+			step.Neighbors = tunaMerkleNeighbors(t.childHashes(paths, branchCursor), branch)
+		}
+		ret = append(ret, step)
+		paths = groups[branch]
+		cursor = branchCursor + 1
+	}
+	return ret, nil
+}
+
+func (t *TunaMerkleTree) paths() []string {
+	ret := make([]string, 0, len(t.entries))
+	for path := range t.entries {
+		ret = append(ret, path)
+	}
+	return ret
+}
+
+// nodeHash returns the hash of the node containing the provided paths, starting at the cursor
+func (t *TunaMerkleTree) nodeHash(paths []string, cursor int) []byte {
+	switch len(paths) {
+	case 0:
+		return tunaMerkleNullHash
+	case 1:
+		return tunaMerkleCombine(tunaMerkleSuffix([]byte(paths[0]), cursor), t.entries[paths[0]])
+	}
+	prefixLen := tunaMerkleCommonPrefixLen(paths, cursor)
+	return tunaMerkleCombine(
+		tunaMerkleNibbles([]byte(paths[0]), cursor, cursor+prefixLen),
+		tunaMerkleRoot(t.childHashes(paths, cursor+prefixLen)),
+	)
+}
+
+// childHashes returns the hashes of the children of a branch at the provided cursor
+func (t *TunaMerkleTree) childHashes(paths []string, branchCursor int) [][]byte {
+	groups := tunaMerkleGroupPaths(paths, branchCursor)
+	ret := make([][]byte, 16)
+	for nibble, group := range groups {
+		ret[nibble] = t.nodeHash(group, branchCursor+1)
+	}
+	return ret
+}
+
+// TunaMerkleProofStepType identifies the type of a step in a TunaMerkleProof
+type TunaMerkleProofStepType uint
+
+const (
+	// Branch step with the hashes of the neighboring subtrees
+	TunaMerkleProofStepTypeBranch TunaMerkleProofStepType = 0
+	// Fork step with a single neighboring branch
+	TunaMerkleProofStepTypeFork TunaMerkleProofStepType = 1
+	// Leaf step with a single neighboring leaf
+	TunaMerkleProofStepTypeLeaf TunaMerkleProofStepType = 2
+)
+
+// TunaMerkleNeighbor represents the neighboring branch in a fork proof step
+type TunaMerkleNeighbor struct {
+	// This allows the type to be used with cbor.DecodeGeneric
+	cbor.StructAsArray
+	Nibble int64
+	// Prefix is the neighbor's prefix, with one nibble per byte
+	Prefix []byte
+	// Root is the Merkle root of the neighbor's children
+	Root []byte
+}
+
+func (n *TunaMerkleNeighbor) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			n.Nibble,
+			n.Prefix,
+			n.Root,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (n *TunaMerkleNeighbor) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 3)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &n.Nibble, &n.Prefix, &n.Root)
+}
+
+// TunaMerkleProofStep represents a single step in a TunaMerkleProof, starting from the root
+type TunaMerkleProofStep struct {
+	Type TunaMerkleProofStepType
+	// Skip is the length of the common prefix skipped at this step
+	Skip int64
+	// Neighbors is used with TunaMerkleProofStepTypeBranch, and contains the hashes of the
+	// neighboring subtrees of 8, 4, 2 and 1 children
+	Neighbors []byte
+	// Neighbor is used with TunaMerkleProofStepTypeFork
+	Neighbor TunaMerkleNeighbor
+	// Key and Value are used with TunaMerkleProofStepTypeLeaf, and contain the neighbor's path
+	// (key hash) and value hash
+	Key   []byte
+	Value []byte
+}
+
+func (s *TunaMerkleProofStep) MarshalCBOR() ([]byte, error) {
+	var tmp cbor.Constructor
+	switch s.Type {
+	case TunaMerkleProofStepTypeBranch:
+		tmp = cbor.NewConstructor(
+			uint(s.Type),
+			cbor.IndefLengthList{
+				s.Skip,
+				encodePlutusBytes(s.Neighbors),
+			},
+		)
+	case TunaMerkleProofStepTypeFork:
+		tmp = cbor.NewConstructor(
+			uint(s.Type),
+			cbor.IndefLengthList{
+				s.Skip,
+				&s.Neighbor,
+			},
+		)
+	case TunaMerkleProofStepTypeLeaf:
+		tmp = cbor.NewConstructor(
+			uint(s.Type),
+			cbor.IndefLengthList{
+				s.Skip,
+				s.Key,
+				s.Value,
+			},
+		)
+	default:
+		return nil, fmt.Errorf("unknown proof step type: %d", s.Type)
+	}
+	return cbor.Encode(&tmp)
+}
+
+func (s *TunaMerkleProofStep) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	s.Type = TunaMerkleProofStepType(constr)
+	switch s.Type {
+	case TunaMerkleProofStepTypeBranch:
+		return decodePlutusFields(fields, &s.Skip, &s.Neighbors)
+	case TunaMerkleProofStepTypeFork:
+		return decodePlutusFields(fields, &s.Skip, &s.Neighbor)
+	case TunaMerkleProofStepTypeLeaf:
+		return decodePlutusFields(fields, &s.Skip, &s.Key, &s.Value)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// TunaMerkleProof is a proof of membership in a TunaMerkleTree
+type TunaMerkleProof []TunaMerkleProofStep
+
+func (p *TunaMerkleProof) MarshalCBOR() ([]byte, error) {
+	var tmp any = []any{}
+	if len(*p) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(*p))
+		for idx := range *p {
+			tmpList = append(tmpList, &(*p)[idx])
+		}
+		tmp = tmpList
+	}
+	return cbor.Encode(&tmp)
+}
+
+func (p *TunaMerkleProof) UnmarshalCBOR(cborData []byte) error {
+	var tmp []TunaMerkleProofStep
+	if _, err := cbor.Decode(cborData, &tmp); err != nil {
+		return err
+	}
+	*p = tmp
+	return nil
+}
+
+// Root returns the root hash of a trie containing the key and value, according to the proof
+func (p TunaMerkleProof) Root(key []byte, value []byte) ([]byte, error) {
+	return p.root(tunaMerkleHash(key), tunaMerkleHash(value), 0)
+}
+
+// Verify returns true if the proof shows that the key and value are a member of the trie with
+// the provided root hash
+func (p TunaMerkleProof) Verify(root []byte, key []byte, value []byte) bool {
+	proofRoot, err := p.Root(key, value)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(proofRoot, root)
+}
+
+func (p TunaMerkleProof) root(path []byte, valueHash []byte, cursor int) ([]byte, error) {
+	if len(p) == 0 {
+		return tunaMerkleCombine(tunaMerkleSuffix(path, cursor), valueHash), nil
+	}
+	step := p[0]
+	if step.Skip < 0 {
+		return nil, fmt.Errorf("invalid proof step skip: %d", step.Skip)
+	}
+	nextCursor := cursor + 1 + int(step.Skip)
+	if nextCursor > tunaMerkleHashSize*2 {
+		return nil, errors.New("proof is longer than the key path")
+	}
+	root, err := p[1:].root(path, valueHash, nextCursor)
+	if err != nil {
+		return nil, err
+	}
+	branch := tunaMerkleNibble(path, nextCursor-1)
+	prefix := tunaMerkleNibbles(path, cursor, nextCursor-1)
+	if step.Type == TunaMerkleProofStepTypeBranch {
+		if len(step.Neighbors) != tunaMerkleHashSize*4 {
+			return nil, fmt.Errorf("invalid proof step neighbors length: %d", len(step.Neighbors))
+		}
+		return tunaMerkleCombine(prefix, tunaMerkleRootFromNeighbors(branch, root, step.Neighbors)), nil
+	}
+	// Fork and leaf steps have a single neighbor, with the other children empty
+	children := make([][]byte, 16)
+	children[branch] = root
+	switch step.Type {
+	case TunaMerkleProofStepTypeFork:
+		if step.Neighbor.Nibble < 0 || step.Neighbor.Nibble > 15 || int(step.Neighbor.Nibble) == branch {
+			return nil, fmt.Errorf("invalid proof step neighbor nibble: %d", step.Neighbor.Nibble)
+		}
+		children[step.Neighbor.Nibble] = tunaMerkleCombine(step.Neighbor.Prefix, step.Neighbor.Root)
+	case TunaMerkleProofStepTypeLeaf:
+		if len(step.Key) != tunaMerkleHashSize {
+			return nil, fmt.Errorf("invalid proof step key length: %d", len(step.Key))
+		}
+		neighborNibble := tunaMerkleNibble(step.Key, nextCursor-1)
+		if neighborNibble == branch {
+			return nil, fmt.Errorf("invalid proof step neighbor nibble: %d", neighborNibble)
+		}
+		children[neighborNibble] = tunaMerkleCombine(tunaMerkleSuffix(step.Key, nextCursor), step.Value)
+	default:
+		return nil, fmt.Errorf("unknown proof step type: %d", step.Type)
+	}
+	return tunaMerkleCombine(prefix, tunaMerkleRoot(children)), nil
+}
+
+func tunaMerkleHash(data []byte) []byte {
+	ret := blake2b.Sum256(data)
+	return ret[:]
+}
+
+func tunaMerkleCombine(left []byte, right []byte) []byte {
+	return tunaMerkleHash(append(append([]byte{}, left...), right...))
+}
+
+// tunaMerkleNibble returns the nibble at the provided index in the path
+func tunaMerkleNibble(path []byte, idx int) int {
+	if idx%2 == 0 {
+		return int(path[idx/2] >> 4)
+	}
+	return int(path[idx/2] & 0x0f)
+}
+
+// tunaMerkleNibbles returns the nibbles in the provided range of the path, one per byte
+func tunaMerkleNibbles(path []byte, start int, end int) []byte {
+	ret := make([]byte, 0, end-start)
+	for idx := start; idx < end; idx++ {
+		ret = append(ret, byte(tunaMerkleNibble(path, idx)))
+	}
+	return ret
+}
+
+// tunaMerkleSuffix returns the encoded remainder of the path from the cursor, as used in leaf
+// hashes. An even cursor is marked with 0xff, and an odd cursor with 0x00 and the first nibble
+func tunaMerkleSuffix(path []byte, cursor int) []byte {
+	if cursor%2 == 0 {
+		return append([]byte{0xff}, path[cursor/2:]...)
+	}
+	return append([]byte{0x00, byte(tunaMerkleNibble(path, cursor))}, path[(cursor+1)/2:]...)
+}
+
+// tunaMerkleCommonPrefixLen returns the length of the common prefix of the paths, from the cursor
+func tunaMerkleCommonPrefixLen(paths []string, cursor int) int {
+	ret := 0
+	for cursor+ret < tunaMerkleHashSize*2 {
+		nibble := tunaMerkleNibble([]byte(paths[0]), cursor+ret)
+		for _, path := range paths[1:] {
+			if tunaMerkleNibble([]byte(path), cursor+ret) != nibble {
+				return ret
+			}
+		}
+		ret++
+	}
+	return ret
+}
+
+// tunaMerkleGroupPaths groups the paths by their nibble at the provided cursor
+func tunaMerkleGroupPaths(paths []string, cursor int) [16][]string {
+	var ret [16][]string
+	for _, path := range paths {
+		nibble := tunaMerkleNibble([]byte(path), cursor)
+		ret[nibble] = append(ret[nibble], path)
+	}
+	return ret
+}
+
+// tunaMerkleRoot returns the Merkle root of the children of a branch, or of a power-of-two sized
+// subset of them. Missing children are represented by the null hash
+func tunaMerkleRoot(children [][]byte) []byte {
+	level := make([][]byte, len(children))
+	for idx, child := range children {
+		level[idx] = child
+		if child == nil {
+			level[idx] = tunaMerkleNullHash
+		}
+	}
+	for len(level) > 1 {
+		nextLevel := make([][]byte, 0, len(level)/2)
+		for idx := 0; idx < len(level); idx += 2 {
+			nextLevel = append(nextLevel, tunaMerkleCombine(level[idx], level[idx+1]))
+		}
+		level = nextLevel
+	}
+	return level[0]
+}
+
+// tunaMerkleNeighbors returns the hashes of the neighboring subtrees of 8, 4, 2 and 1 children
+// for the provided branch
+func tunaMerkleNeighbors(children [][]byte, branch int) []byte {
+	ret := make([]byte, 0, tunaMerkleHashSize*4)
+	start, end := 0, 16
+	for size := 8; size >= 1; size /= 2 {
+		mid := start + size
+		if branch < mid {
+			ret = append(ret, tunaMerkleRoot(children[mid:end])...)
+			end = mid
+		} else {
+			ret = append(ret, tunaMerkleRoot(children[start:mid])...)
+			start = mid
+		}
+	}
+	return ret
+}
+
+// tunaMerkleRootFromNeighbors returns the Merkle root of the children of a branch, given the
+// hash of one child and the hashes of the neighboring subtrees
+func tunaMerkleRootFromNeighbors(branch int, root []byte, neighbors []byte) []byte {
+	ret := root
+	// Neighbors are ordered from the largest subtree to the smallest
+	for level := 0; level < 4; level++ {
+		neighbor := neighbors[(3-level)*tunaMerkleHashSize : (4-level)*tunaMerkleHashSize]
+		if (branch>>level)&1 == 0 {
+			ret = tunaMerkleCombine(ret, neighbor)
+		} else {
+			ret = tunaMerkleCombine(neighbor, ret)
+		}
+	}
+	return ret
+}
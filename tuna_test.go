@@ -0,0 +1,808 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+func TestTunaHardForkLockStateDecodeEncode(t *testing.T) {
+	testCborHex := "d8799f197a081b00000162b1b74200ff"
+	expectedObj := models.TunaHardForkLockState{
+		BlockHeight:       31240,
+		CurrentLockedTuna: 1523400000000,
+	}
+	var testObj models.TunaHardForkLockState
+	testDecodeEncode(t, testCborHex, &testObj, &expectedObj)
+}
+
+func TestTunaHardForkLockStateInvalid(t *testing.T) {
+	for _, cborHex := range []string{"d86580", "d8659f00ff", "d8799fd865801a00361206ffff"} {
+		if _, err := models.DecodeHex[models.TunaHardForkLockState](cborHex); err == nil {
+			t.Fatalf("did not get expected error decoding %s", cborHex)
+		}
+	}
+	_, err := models.DecodeHex[models.TunaHardForkLockState]("d87a9f197a081b00000162b1b74200ff")
+	if !errors.Is(err, models.ErrUnexpectedConstructor) {
+		t.Fatalf("did not get expected error: %v", err)
+	}
+}
+
+func TestTunaMinerCredentialDecodeEncode(t *testing.T) {
+	pkhCredential := models.TunaMinerCredential{
+		Type:       models.TunaMinerCredentialTypePkh,
+		PubKeyHash: decodeHex("0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c"),
+		Extra:      []byte("tuna"),
+	}
+	var testPkhObj models.TunaMinerCredential
+	testDecodeEncode(
+		t,
+		"d8799f581c0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c4474756e61ff",
+		&testPkhObj,
+		&pkhCredential,
+	)
+	payoutCredential, err := testPkhObj.PayoutCredential()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if payoutCredential.Type != models.PlutusCredentialTypePubKey ||
+		!bytes.Equal(payoutCredential.Hash, pkhCredential.PubKeyHash) {
+		t.Fatalf("did not get expected payout credential: %#v", payoutCredential)
+	}
+	if _, err := testPkhObj.NftAsset(); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+
+	nftCredential := models.TunaMinerCredential{
+		Type:        models.TunaMinerCredentialTypeNft,
+		PolicyId:    decodeHex("6465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f"),
+		AssetName:   []byte("miner"),
+		OutputIndex: 2,
+	}
+	var testNftObj models.TunaMinerCredential
+	testDecodeEncode(
+		t,
+		"d87a9f581c6465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f456d696e657202ff",
+		&testNftObj,
+		&nftCredential,
+	)
+	asset, err := testNftObj.NftAsset()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !bytes.Equal(asset.PolicyId, nftCredential.PolicyId) || string(asset.AssetName) != "miner" {
+		t.Fatalf("did not get expected NFT asset: %#v", asset)
+	}
+	if _, err := testNftObj.PayoutCredential(); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
+
+func TestTunaMinerCredentialInvalid(t *testing.T) {
+	for _, cborHex := range []string{"d86580", "d8659f00ff", "d8799fd865801a00361206ffff"} {
+		if _, err := models.DecodeHex[models.TunaMinerCredential](cborHex); err == nil {
+			t.Fatalf("did not get expected error decoding %s", cborHex)
+		}
+	}
+	_, err := models.DecodeHex[models.TunaMinerCredential]("d87b80")
+	if !errors.Is(err, models.ErrUnexpectedConstructor) {
+		t.Fatalf("did not get expected error: %v", err)
+	}
+}
+
+func TestTunaHashDifficulty(t *testing.T) {
+	hash := decodeHex("00000abcd3f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b")
+	leadingZeros, difficultyNumber := models.TunaHashDifficulty(hash)
+	if leadingZeros != 5 || difficultyNumber != 0xabcd {
+		t.Fatalf("did not get expected hash difficulty: %d, %d", leadingZeros, difficultyNumber)
+	}
+	testDefs := []struct {
+		leadingZeros     int64
+		difficultyNumber int64
+		meetsTarget      bool
+	}{
+		{4, 65535, true},
+		{5, 65535, true},
+		{5, 0xabcd, false},
+		{5, 0xabcc, false},
+		{6, 4096, false},
+	}
+	for _, testDef := range testDefs {
+		if models.TunaMeetsTarget(hash, testDef.leadingZeros, testDef.difficultyNumber) != testDef.meetsTarget {
+			t.Fatalf(
+				"did not get expected result for leading zeros %d, difficulty number %d",
+				testDef.leadingZeros,
+				testDef.difficultyNumber,
+			)
+		}
+	}
+	state := models.TunaV2State{LeadingZeros: 5, DifficultyNumber: 0xabce}
+	if !state.MeetsTarget(hash) {
+		t.Fatalf("hash did not meet state target")
+	}
+	if new(big.Int).SetBytes(hash).Cmp(state.Target()) >= 0 {
+		t.Fatalf("hash was not less than state target")
+	}
+	expectedTarget, _ := new(big.Int).SetString("00000abce"+strings.Repeat("0", 55), 16)
+	if state.Target().Cmp(expectedTarget) != 0 {
+		t.Fatalf("did not get expected target: %x", state.Target())
+	}
+}
+
+func TestTunaTargetMaxLeadingZeros(t *testing.T) {
+	testDefs := []struct {
+		leadingZeros     int64
+		difficultyNumber int64
+		expectedTarget   int64
+	}{
+		{60, 0x1234, 0x1234},
+		{61, 0x1234, 0x123},
+		{62, 0x1234, 0x12},
+	}
+	for _, testDef := range testDefs {
+		target := models.TunaTarget(testDef.leadingZeros, testDef.difficultyNumber)
+		if target.Cmp(big.NewInt(testDef.expectedTarget)) != 0 {
+			t.Fatalf(
+				"did not get expected target for leading zeros %d: got %x, wanted %x",
+				testDef.leadingZeros,
+				target,
+				testDef.expectedTarget,
+			)
+		}
+		state := models.TunaV1State{
+			BlockNumber:      1,
+			CurrentHash:      make([]byte, 32),
+			LeadingZeros:     testDef.leadingZeros,
+			DifficultyNumber: testDef.difficultyNumber,
+			RealTimeNow:      1700000000000,
+		}
+		expectedDifficulty := new(big.Int).Lsh(big.NewInt(1), 256)
+		expectedDifficulty.Div(expectedDifficulty, big.NewInt(testDef.expectedTarget))
+		if state.Difficulty().Cmp(expectedDifficulty) != 0 {
+			t.Fatalf("did not get expected difficulty: %s", state.Difficulty())
+		}
+		_ = state.Metrics()
+	}
+}
+
+func TestTunaDifficultyAdjustment(t *testing.T) {
+	adjustmentDefs := []struct {
+		totalEpochTime int64
+		numerator      int64
+		denominator    int64
+	}{
+		{models.TunaEpochTarget, models.TunaEpochTarget, models.TunaEpochTarget},
+		{models.TunaEpochTarget / 2, models.TunaEpochTarget / 2, models.TunaEpochTarget},
+		{models.TunaEpochTarget/5 + 1, 1, 4},
+		{models.TunaEpochTarget*5 + 1, 4, 1},
+	}
+	for _, testDef := range adjustmentDefs {
+		numerator, denominator := models.TunaDifficultyAdjustment(testDef.totalEpochTime)
+		if numerator != testDef.numerator || denominator != testDef.denominator {
+			t.Fatalf(
+				"did not get expected adjustment for epoch time %d: got %d/%d",
+				testDef.totalEpochTime,
+				numerator,
+				denominator,
+			)
+		}
+	}
+	difficultyDefs := []struct {
+		difficultyNumber     int64
+		leadingZeros         int64
+		numerator            int64
+		denominator          int64
+		expectedDifficulty   int64
+		expectedLeadingZeros int64
+	}{
+		{65535, 4, 1, 4, 16383, 4},
+		{4096, 4, 1, 4, 16384, 5},
+		{65535, 4, 4, 1, 16383, 3},
+		{65535, 2, 4, 1, 65535, 2},
+		{4096, 62, 1, 4, 4096, 62},
+		{40000, 4, 1, 1, 40000, 4},
+	}
+	for _, testDef := range difficultyDefs {
+		difficultyNumber, leadingZeros := models.TunaNextDifficulty(
+			testDef.difficultyNumber,
+			testDef.leadingZeros,
+			testDef.numerator,
+			testDef.denominator,
+		)
+		if difficultyNumber != testDef.expectedDifficulty || leadingZeros != testDef.expectedLeadingZeros {
+			t.Fatalf(
+				"did not get expected difficulty for %#v: got %d, %d",
+				testDef,
+				difficultyNumber,
+				leadingZeros,
+			)
+		}
+	}
+}
+
+func TestTunaMerkleTree(t *testing.T) {
+	tree := models.NewTunaMerkleTree()
+	if !bytes.Equal(tree.Root(), make([]byte, 32)) {
+		t.Fatalf("did not get expected empty root: %x", tree.Root())
+	}
+	var blockHashes [][]byte
+	for i := 0; i < 64; i++ {
+		blockHash := sha256.Sum256([]byte{byte(i)})
+		blockHashes = append(blockHashes, blockHash[:])
+	}
+	// Check proofs with tries of various sizes, to cover all proof step types
+	stepTypes := make(map[models.TunaMerkleProofStepType]bool)
+	for _, numHashes := range []int{1, 2, 3, 17, 64} {
+		tree, err := models.NewTunaMerkleTreeFromBlockHashes(blockHashes[:numHashes])
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		root := tree.Root()
+		for _, blockHash := range blockHashes[:numHashes] {
+			proof, err := tree.Prove(blockHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !proof.Verify(root, blockHash, blockHash) {
+				t.Fatalf("proof did not verify for block hash %x in trie of size %d", blockHash, numHashes)
+			}
+			if proof.Verify(root, blockHash, []byte("wrong")) {
+				t.Fatalf("proof verified with wrong value")
+			}
+			for _, step := range proof {
+				stepTypes[step.Type] = true
+			}
+			// Proof survives a CBOR round trip
+			cborData, err := cbor.Encode(&proof)
+			if err != nil {
+				t.Fatalf("unexpected error encoding proof: %s", err)
+			}
+			var decodedProof models.TunaMerkleProof
+			if _, err := cbor.Decode(cborData, &decodedProof); err != nil {
+				t.Fatalf("unexpected error decoding proof: %s", err)
+			}
+			if !decodedProof.Verify(root, blockHash, blockHash) {
+				t.Fatalf("decoded proof did not verify")
+			}
+		}
+		if _, err := tree.Prove(blockHashes[numHashes%64]); numHashes < 64 && err == nil {
+			t.Fatalf("did not get expected error proving missing key")
+		}
+	}
+	// Fork steps need a branch with exactly two children, one of which is another branch, so
+	// search for a set of three block hashes that produces one
+	for i := 0; i < len(blockHashes)-2 && !stepTypes[models.TunaMerkleProofStepTypeFork]; i++ {
+		tree, err := models.NewTunaMerkleTreeFromBlockHashes(blockHashes[i : i+3])
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		for _, blockHash := range blockHashes[i : i+3] {
+			proof, err := tree.Prove(blockHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(proof) > 0 && proof[0].Type == models.TunaMerkleProofStepTypeFork {
+				if !proof.Verify(tree.Root(), blockHash, blockHash) {
+					t.Fatalf("fork proof did not verify for block hash %x", blockHash)
+				}
+				stepTypes[models.TunaMerkleProofStepTypeFork] = true
+			}
+		}
+	}
+	if len(stepTypes) != 3 {
+		t.Fatalf("did not cover all proof step types: %v", stepTypes)
+	}
+	// Insertion order does not affect the root
+	reversed := models.NewTunaMerkleTree()
+	for i := len(blockHashes) - 1; i >= 0; i-- {
+		if err := reversed.Insert(blockHashes[i], blockHashes[i]); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	full, _ := models.NewTunaMerkleTreeFromBlockHashes(blockHashes)
+	if !bytes.Equal(reversed.Root(), full.Root()) {
+		t.Fatalf("root depends on insertion order")
+	}
+	if err := reversed.Insert(blockHashes[0], blockHashes[0]); err == nil {
+		t.Fatalf("did not get expected error inserting duplicate key")
+	}
+}
+
+func TestTunaMerkleProofDecodeEncode(t *testing.T) {
+	testCborHex := "9fd8799f015f584000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000584000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000ffffd87b9f00420102420304ffff"
+	expectedObj := models.TunaMerkleProof{
+		{
+			Type:      models.TunaMerkleProofStepTypeBranch,
+			Skip:      1,
+			Neighbors: make([]byte, 128),
+		},
+		{
+			Type:  models.TunaMerkleProofStepTypeLeaf,
+			Skip:  0,
+			Key:   []byte{0x01, 0x02},
+			Value: []byte{0x03, 0x04},
+		},
+	}
+	var testObj models.TunaMerkleProof
+	testDecodeEncode(t, testCborHex, &testObj, &expectedObj)
+}
+
+func TestTunaMerkleProofInvalid(t *testing.T) {
+	for _, cborHex := range []string{"9fd86580ff", "9fd8659f00ffff", "9fd8799fd865801a00361206ffffff"} {
+		if _, err := models.DecodeHex[models.TunaMerkleProof](cborHex); err == nil {
+			t.Fatalf("did not get expected error decoding %s", cborHex)
+		}
+	}
+	_, err := models.DecodeHex[models.TunaMerkleProof]("9fd87c80ff")
+	if !errors.Is(err, models.ErrUnexpectedConstructor) {
+		t.Fatalf("did not get expected error: %v", err)
+	}
+}
+
+func TestTunaStateJson(t *testing.T) {
+	testDefs := []struct {
+		cborHex      string
+		newObj       func() any
+		expectedJson string
+	}{
+		{
+			cborHex:      "d8799f1910e1582000000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a9308199c401a075bcd151b0000018bcfe56800d879809f58200000000f4b0b5ba9a0c7c0ed1d2c2d5a0e8f7c3e7b1f1c6d5a4b3c2d1e0f1a2b582000000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a93ffff",
+			newObj:       func() any { return &models.TunaV1State{} },
+			expectedJson: `{"blockNumber":4321,"currentHash":"00000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a93","leadingZeros":8,"difficultyNumber":40000,"epochTime":123456789,"realTimeNow":1700000000000,"extra":"d87980","interlink":["0000000f4b0b5ba9a0c7c0ed1d2c2d5a0e8f7c3e7b1f1c6d5a4b3c2d1e0f1a2b","00000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a93"]}`,
+		},
+		{
+			cborHex:      "d8799f1910e1582000000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a9308199c401a075bcd151b0000018bcfe568005820000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1fff",
+			newObj:       func() any { return &models.TunaV2State{} },
+			expectedJson: `{"blockNumber":4321,"currentHash":"00000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a93","leadingZeros":8,"difficultyNumber":40000,"epochTime":123456789,"currentPosixTime":1700000000000,"merkleRoot":"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}`,
+		},
+	}
+	for _, testDef := range testDefs {
+		testObj := testDef.newObj()
+		if _, err := cbor.Decode(decodeHex(testDef.cborHex), testObj); err != nil {
+			t.Fatalf("unexpected error decoding CBOR: %s", err)
+		}
+		jsonData, err := json.Marshal(testObj)
+		if err != nil {
+			t.Fatalf("unexpected error encoding JSON: %s", err)
+		}
+		if string(jsonData) != testDef.expectedJson {
+			t.Fatalf("did not get expected JSON\n  got: %s\n  wanted: %s", jsonData, testDef.expectedJson)
+		}
+		// Decoding the JSON gives back the original datum
+		jsonObj := testDef.newObj()
+		if err := json.Unmarshal(jsonData, jsonObj); err != nil {
+			t.Fatalf("unexpected error decoding JSON: %s", err)
+		}
+		cborData, err := cbor.Encode(jsonObj)
+		if err != nil {
+			t.Fatalf("unexpected error encoding CBOR: %s", err)
+		}
+		if hex.EncodeToString(cborData) != testDef.cborHex {
+			t.Fatalf("did not get expected CBOR\n  got: %x\n  wanted: %s", cborData, testDef.cborHex)
+		}
+	}
+}
+
+func TestTunaStateValidate(t *testing.T) {
+	hash := decodeHex("00000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a93")
+	validV1 := models.TunaV1State{
+		BlockNumber:      4321,
+		CurrentHash:      hash,
+		LeadingZeros:     8,
+		DifficultyNumber: 40000,
+		EpochTime:        123456789,
+		RealTimeNow:      1700000000000,
+		Interlink:        [][]byte{hash},
+	}
+	if err := validV1.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+	validV2 := models.TunaV2State{
+		BlockNumber:      4321,
+		CurrentHash:      hash,
+		LeadingZeros:     8,
+		DifficultyNumber: 40000,
+		EpochTime:        123456789,
+		CurrentPosixTime: 1700000000000,
+		MerkleRoot:       make([]byte, 32),
+	}
+	if err := validV2.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+	invalidV1 := []func(*models.TunaV1State){
+		func(s *models.TunaV1State) { s.BlockNumber = -1 },
+		func(s *models.TunaV1State) { s.CurrentHash = hash[:31] },
+		func(s *models.TunaV1State) { s.LeadingZeros = 1 },
+		func(s *models.TunaV1State) { s.LeadingZeros = 63 },
+		func(s *models.TunaV1State) { s.DifficultyNumber = 65536 },
+		func(s *models.TunaV1State) { s.EpochTime = -1 },
+		func(s *models.TunaV1State) { s.RealTimeNow = 1700000000 },
+		func(s *models.TunaV1State) { s.Interlink = [][]byte{{0x01}} },
+	}
+	for idx, modify := range invalidV1 {
+		state := validV1
+		modify(&state)
+		if err := state.Validate(); err == nil {
+			t.Fatalf("did not get expected validation error for V1 test %d", idx)
+		}
+	}
+	invalidV2 := validV2
+	invalidV2.MerkleRoot = nil
+	if err := invalidV2.Validate(); err == nil {
+		t.Fatalf("did not get expected validation error for V2 merkle root")
+	}
+	lockState := models.TunaHardForkLockState{BlockHeight: 31240, CurrentLockedTuna: -1}
+	if err := lockState.Validate(); err == nil {
+		t.Fatalf("did not get expected validation error for lock state")
+	}
+}
+
+func TestNewTunaV2StateFromV1(t *testing.T) {
+	hash := decodeHex("00000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a93")
+	v1State := models.TunaV1State{
+		BlockNumber:      4321,
+		CurrentHash:      hash,
+		LeadingZeros:     8,
+		DifficultyNumber: 40000,
+		EpochTime:        123456789,
+		RealTimeNow:      1700000000000,
+		Interlink:        [][]byte{hash},
+	}
+	v2State, err := models.NewTunaV2StateFromV1(v1State)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	tree, _ := models.NewTunaMerkleTreeFromBlockHashes([][]byte{hash})
+	expectedState := models.TunaV2State{
+		BlockNumber:      4321,
+		CurrentHash:      hash,
+		LeadingZeros:     8,
+		DifficultyNumber: 40000,
+		EpochTime:        123456789,
+		CurrentPosixTime: 1700000000000,
+		MerkleRoot:       tree.Root(),
+	}
+	if !reflect.DeepEqual(v2State, expectedState) {
+		t.Fatalf("did not get expected V2 state: %#v", v2State)
+	}
+	if err := v2State.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+	v1State.CurrentHash = nil
+	if _, err := models.NewTunaV2StateFromV1(v1State); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
+
+func TestTunaInterlink(t *testing.T) {
+	state := models.TunaV1State{
+		LeadingZeros:     4,
+		DifficultyNumber: 0x8000,
+		Interlink: [][]byte{
+			decodeHex("aa"),
+			decodeHex("bb"),
+			decodeHex("cc"),
+		},
+	}
+	// Hash is harder than the first two levels (4 zeros with 0x4000 and 0x2000), but not the
+	// third (4 zeros with 0x1000)
+	blockHash := decodeHex("00001fff" + strings.Repeat("00", 28))
+	interlink := state.NextInterlink(blockHash)
+	expectedInterlink := [][]byte{blockHash, blockHash, decodeHex("cc")}
+	if !reflect.DeepEqual(interlink, expectedInterlink) {
+		t.Fatalf("did not get expected interlink: %x", interlink)
+	}
+	if !bytes.Equal(state.Interlink[0], decodeHex("aa")) {
+		t.Fatalf("original interlink was modified")
+	}
+	// Interlink grows as needed
+	interlink = models.TunaInterlink(nil, blockHash, 4, 0x8000)
+	if !reflect.DeepEqual(interlink, [][]byte{blockHash, blockHash}) {
+		t.Fatalf("did not get expected interlink: %x", interlink)
+	}
+	// Hash that only meets the target
+	interlink = models.TunaInterlink(state.Interlink, decodeHex("00007fff"+strings.Repeat("00", 28)), 4, 0x8000)
+	if !reflect.DeepEqual(interlink, state.Interlink) {
+		t.Fatalf("did not get expected interlink: %x", interlink)
+	}
+	// An all-zero hash fills every level without looping forever
+	interlink = models.TunaInterlink(nil, make([]byte, 32), 4, 0x8000)
+	if len(interlink) == 0 {
+		t.Fatalf("did not get expected interlink")
+	}
+}
+
+// findTunaNonce returns a nonce that produces a block hash meeting the target of the state
+func findTunaNonce(t *testing.T, blockHash func([]byte) ([]byte, error), meetsTarget func([]byte) bool) []byte {
+	t.Helper()
+	for i := 0; i < 100000; i++ {
+		nonce := make([]byte, 16)
+		big.NewInt(int64(i)).FillBytes(nonce)
+		hash, err := blockHash(nonce)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if meetsTarget(hash) {
+			return nonce
+		}
+	}
+	t.Fatalf("could not find nonce")
+	return nil
+}
+
+func TestTunaV1StateNext(t *testing.T) {
+	state := models.TunaV1State{
+		BlockNumber:      models.TunaEpochNumber,
+		CurrentHash:      decodeHex("00000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a93"),
+		LeadingZeros:     1,
+		DifficultyNumber: 65535,
+		EpochTime:        models.TunaEpochTarget / 5,
+		RealTimeNow:      1700000000000,
+		Extra:            []byte("extra"),
+	}
+	nonce := findTunaNonce(t, state.BlockHash, state.MeetsTarget)
+	blockHash, _ := state.BlockHash(nonce)
+	nextState, err := state.Next(nonce, state.RealTimeNow+1000)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// The block completes an epoch that was much faster than the target, so the difficulty is
+	// increased by the maximum factor of 4 and the epoch time is reset
+	expectedState := models.TunaV1State{
+		BlockNumber:      models.TunaEpochNumber + 1,
+		CurrentHash:      blockHash,
+		LeadingZeros:     1,
+		DifficultyNumber: 16383,
+		EpochTime:        0,
+		RealTimeNow:      state.RealTimeNow + 1000,
+		Extra:            []byte("extra"),
+		Interlink:        state.NextInterlink(blockHash),
+	}
+	if !reflect.DeepEqual(nextState, expectedState) {
+		t.Fatalf("did not get expected state\n  got: %#v\n  wanted: %#v", nextState, expectedState)
+	}
+	// Within an epoch, the epoch time accumulates
+	nonce = findTunaNonce(t, nextState.BlockHash, nextState.MeetsTarget)
+	finalState, err := nextState.Next(nonce, nextState.RealTimeNow+5000)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if finalState.EpochTime != 5000 || finalState.DifficultyNumber != 16383 {
+		t.Fatalf("did not get expected state: %#v", finalState)
+	}
+	// A nonce that doesn't meet the target fails
+	state.LeadingZeros = 60
+	if _, err := state.Next(nonce, state.RealTimeNow+1000); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
+
+func TestTunaV2StateNext(t *testing.T) {
+	currentHash := decodeHex("00000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a93")
+	tree, _ := models.NewTunaMerkleTreeFromBlockHashes([][]byte{currentHash})
+	state := models.TunaV2State{
+		BlockNumber:      100,
+		CurrentHash:      currentHash,
+		LeadingZeros:     1,
+		DifficultyNumber: 65535,
+		EpochTime:        1000,
+		CurrentPosixTime: 1700000000000,
+		MerkleRoot:       tree.Root(),
+	}
+	miner := models.TunaMinerCredential{
+		Type:       models.TunaMinerCredentialTypePkh,
+		PubKeyHash: decodeHex("0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c"),
+		Extra:      []byte{},
+	}
+	blockHash := func(nonce []byte) ([]byte, error) {
+		return state.BlockHash(nonce, miner)
+	}
+	nonce := findTunaNonce(t, blockHash, state.MeetsTarget)
+	// The trie must match the current root
+	if _, err := state.Next(nonce, miner, state.CurrentPosixTime+2000, models.NewTunaMerkleTree()); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+	nextState, err := state.Next(nonce, miner, state.CurrentPosixTime+2000, tree)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	newHash, _ := state.BlockHash(nonce, miner)
+	expectedTree, _ := models.NewTunaMerkleTreeFromBlockHashes([][]byte{currentHash, newHash})
+	expectedState := models.TunaV2State{
+		BlockNumber:      101,
+		CurrentHash:      newHash,
+		LeadingZeros:     1,
+		DifficultyNumber: 65535,
+		EpochTime:        3000,
+		CurrentPosixTime: state.CurrentPosixTime + 2000,
+		MerkleRoot:       expectedTree.Root(),
+	}
+	if !reflect.DeepEqual(nextState, expectedState) {
+		t.Fatalf("did not get expected state\n  got: %#v\n  wanted: %#v", nextState, expectedState)
+	}
+}
+
+func TestDecodeTunaState(t *testing.T) {
+	v1Hex := "d8799f1910e1582000000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a9308199c401a075bcd151b0000018bcfe56800d879809f58200000000f4b0b5ba9a0c7c0ed1d2c2d5a0e8f7c3e7b1f1c6d5a4b3c2d1e0f1a2b582000000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a93ffff"
+	state, err := models.DecodeTunaState(decodeHex(v1Hex))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	v1State, ok := state.(*models.TunaV1State)
+	if !ok {
+		t.Fatalf("did not get expected V1 state: %T", state)
+	}
+	if v1State.BlockNumber != 4321 || len(v1State.Interlink) != 2 {
+		t.Fatalf("did not get expected V1 state: %#v", v1State)
+	}
+	v2Hex := "d8799f1910e1582000000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a9308199c401a075bcd151b0000018bcfe568005820000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1fff"
+	state, err = models.DecodeTunaState(decodeHex(v2Hex))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	v2State, ok := state.(*models.TunaV2State)
+	if !ok {
+		t.Fatalf("did not get expected V2 state: %T", state)
+	}
+	if v2State.CurrentPosixTime != 1700000000000 || len(v2State.MerkleRoot) != 32 {
+		t.Fatalf("did not get expected V2 state: %#v", v2State)
+	}
+	// Version hint
+	if _, err := models.DecodeTunaState(decodeHex(v2Hex), models.WithVersionHint(2)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := models.DecodeTunaState(decodeHex(v2Hex), models.WithVersionHint(1)); !errors.Is(err, models.ErrInvalidVersion) {
+		t.Fatalf("did not get expected error: %v", err)
+	}
+	// Unknown layout
+	if _, err := models.DecodeTunaState(decodeHex("d8799f0102ff")); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+	// Unexpected constructor
+	if _, err := models.DecodeTunaState(decodeHex("d87a9f0102ff")); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
+
+func TestTunaMiningState(t *testing.T) {
+	hash := decodeHex("00000000bd0d4a3e2d8cb3ee4ee1d1ddc1bfeab6a87bbd8b05c9b3b3ec5e4a93")
+	states := []models.MiningState{
+		models.TunaV1State{
+			BlockNumber:      4321,
+			CurrentHash:      hash,
+			LeadingZeros:     8,
+			DifficultyNumber: 32768,
+			RealTimeNow:      1700000000000,
+		},
+		models.TunaV2State{
+			BlockNumber:      4321,
+			CurrentHash:      hash,
+			LeadingZeros:     8,
+			DifficultyNumber: 32768,
+			CurrentPosixTime: 1700000000000,
+		},
+	}
+	// A target of 0x8000 after 8 zero hex digits is 2^(256-32-1), so 2^33 hashes are expected
+	expectedDifficulty := new(big.Int).Lsh(big.NewInt(1), 33)
+	for _, state := range states {
+		if state.Height() != 4321 || !bytes.Equal(state.TipHash(), hash) {
+			t.Fatalf("did not get expected block: %d, %x", state.Height(), state.TipHash())
+		}
+		if state.Difficulty().Cmp(expectedDifficulty) != 0 {
+			t.Fatalf("did not get expected difficulty: %s", state.Difficulty())
+		}
+		if !state.Timestamp().Equal(time.UnixMilli(1700000000000)) {
+			t.Fatalf("did not get expected timestamp: %s", state.Timestamp())
+		}
+	}
+}
+
+func TestTunaMetrics(t *testing.T) {
+	state := models.TunaV2State{
+		BlockNumber:      models.TunaEpochNumber + 101,
+		LeadingZeros:     8,
+		DifficultyNumber: 32768,
+		// 100 blocks in 60000 seconds
+		EpochTime:        60_000_000,
+		CurrentPosixTime: 1700000000000,
+	}
+	expectedMetrics := models.TunaMetrics{
+		BlockNumber:         models.TunaEpochNumber + 101,
+		LeadingZeros:        8,
+		DifficultyNumber:    32768,
+		Difficulty:          8589934592,
+		EpochBlocks:         100,
+		EpochSeconds:        60000,
+		AverageBlockSeconds: 600,
+		BlocksPerHour:       6,
+		HashRate:            8589934592.0 / 600,
+		Timestamp:           1700000000000,
+	}
+	if metrics := state.Metrics(); !reflect.DeepEqual(metrics, expectedMetrics) {
+		t.Fatalf("did not get expected metrics\n  got: %#v\n  wanted: %#v", metrics, expectedMetrics)
+	}
+	// No rates are available at the start of an epoch
+	v1State := models.TunaV1State{
+		BlockNumber:      models.TunaEpochNumber + 1,
+		LeadingZeros:     8,
+		DifficultyNumber: 32768,
+		RealTimeNow:      1700000000000,
+	}
+	metrics := v1State.Metrics()
+	if metrics.EpochBlocks != 0 || metrics.HashRate != 0 || metrics.BlocksPerHour != 0 {
+		t.Fatalf("did not get expected metrics: %#v", metrics)
+	}
+	// The first epoch counts from the genesis block
+	v1State.BlockNumber = 10
+	v1State.EpochTime = 6_000_000
+	if metrics := v1State.Metrics(); metrics.EpochBlocks != 10 || metrics.AverageBlockSeconds != 600 {
+		t.Fatalf("did not get expected metrics: %#v", metrics)
+	}
+}
+
+func TestTunaStateLogValue(t *testing.T) {
+	state := models.TunaV1State{
+		BlockNumber:      4321,
+		CurrentHash:      decodeHex("00000000bd0d4a3e"),
+		LeadingZeros:     8,
+		DifficultyNumber: 32768,
+		Interlink:        [][]byte{{0x01}, {0x02}},
+	}
+	expected := "[blockNumber=4321 currentHash=00000000bd0d4a3e leadingZeros=8 difficultyNumber=32768 interlinkLen=2]"
+	if logValue := state.LogValue().String(); logValue != expected {
+		t.Fatalf("did not get expected log value\n  got:    %s\n  wanted: %s", logValue, expected)
+	}
+}
+
+func TestTunaStateString(t *testing.T) {
+	v1State := models.TunaV1State{
+		BlockNumber:      4321,
+		CurrentHash:      decodeHex("00000000bd0d4a3e"),
+		LeadingZeros:     8,
+		DifficultyNumber: 32768,
+		EpochTime:        600000,
+		RealTimeNow:      1700000000000,
+	}
+	expected := "TunaV1State { BlockNumber = 4321, CurrentHash = 00000000bd0d4a3e, LeadingZeros = 8, DifficultyNumber = 32768, EpochTime = 600000, RealTimeNow = 1700000000000 }"
+	if v1State.String() != expected {
+		t.Fatalf("did not get expected string\n  got:    %s\n  wanted: %s", v1State.String(), expected)
+	}
+	v2State := models.TunaV2State{
+		BlockNumber:      4321,
+		CurrentHash:      decodeHex("00000000bd0d4a3e"),
+		LeadingZeros:     8,
+		DifficultyNumber: 32768,
+		EpochTime:        600000,
+		CurrentPosixTime: 1700000000000,
+		MerkleRoot:       decodeHex("abcd"),
+	}
+	expected = "TunaV2State { BlockNumber = 4321, CurrentHash = 00000000bd0d4a3e, LeadingZeros = 8, DifficultyNumber = 32768, EpochTime = 600000, CurrentPosixTime = 1700000000000, MerkleRoot = abcd }"
+	if v2State.String() != expected {
+		t.Fatalf("did not get expected string\n  got:    %s\n  wanted: %s", v2State.String(), expected)
+	}
+}
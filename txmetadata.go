@@ -0,0 +1,288 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"gopkg.in/yaml.v3"
+)
+
+// Transaction metadata labels with typed models in this package
+const (
+	Cip20MetadataLabel = 674
+	Cip27MetadataLabel = 777
+)
+
+// TxMetadata represents the full metadata map for a transaction, providing typed access to the
+// labels supported by this package while preserving any others
+type TxMetadata struct {
+	Cip20 *Cip20Metadata
+	Cip27 *Cip27Metadata
+	// Other holds the raw CBOR for any labels without a typed model
+	Other map[uint64]cbor.RawMessage
+}
+
+func (t *TxMetadata) UnmarshalCBOR(cborData []byte) error {
+	var raw map[uint64]cbor.RawMessage
+	if _, err := cbor.Decode(cborData, &raw); err != nil {
+		return err
+	}
+	t.Cip20 = nil
+	t.Cip27 = nil
+	t.Other = nil
+	for label, val := range raw {
+		switch label {
+		case Cip20MetadataLabel:
+			t.Cip20 = &Cip20Metadata{}
+			if _, err := cbor.Decode(val, &t.Cip20.Num674); err != nil {
+				return err
+			}
+			if err := t.Cip20.Validate(); err != nil {
+				return err
+			}
+		case Cip27MetadataLabel:
+			t.Cip27 = &Cip27Metadata{}
+			if _, err := cbor.Decode(val, &t.Cip27.Num777); err != nil {
+				return err
+			}
+			if err := t.Cip27.Validate(); err != nil {
+				return err
+			}
+		default:
+			if t.Other == nil {
+				t.Other = make(map[uint64]cbor.RawMessage)
+			}
+			t.Other[label] = val
+		}
+	}
+	return nil
+}
+
+func (t *TxMetadata) MarshalCBOR() ([]byte, error) {
+	tmpMap := make(map[uint64]cbor.RawMessage, len(t.Other)+2)
+	for label, val := range t.Other {
+		tmpMap[label] = val
+	}
+	// Each typed model encodes its own label map, which we merge into the result
+	for _, item := range t.typedItems() {
+		itemCbor, err := cbor.Encode(item)
+		if err != nil {
+			return nil, err
+		}
+		var itemMap map[uint64]cbor.RawMessage
+		if _, err := cbor.Decode(itemCbor, &itemMap); err != nil {
+			return nil, err
+		}
+		for label, val := range itemMap {
+			tmpMap[label] = val
+		}
+	}
+	return cbor.Encode(tmpMap)
+}
+
+func (t *TxMetadata) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	t.Cip20 = nil
+	t.Cip27 = nil
+	t.Other = nil
+	for key, val := range raw {
+		label, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return err
+		}
+		switch label {
+		case Cip20MetadataLabel:
+			t.Cip20 = &Cip20Metadata{}
+			if err := json.Unmarshal(val, &t.Cip20.Num674); err != nil {
+				return err
+			}
+			if err := t.Cip20.Validate(); err != nil {
+				return err
+			}
+		case Cip27MetadataLabel:
+			t.Cip27 = &Cip27Metadata{}
+			if err := json.Unmarshal(val, &t.Cip27.Num777); err != nil {
+				return err
+			}
+			if err := t.Cip27.Validate(); err != nil {
+				return err
+			}
+		default:
+			// Labels without a typed model are stored as CBOR
+			dec := json.NewDecoder(bytes.NewReader(val))
+			dec.UseNumber()
+			var tmpVal any
+			if err := dec.Decode(&tmpVal); err != nil {
+				return err
+			}
+			metadatum, err := jsonToMetadatum(tmpVal)
+			if err != nil {
+				return fmt.Errorf("invalid metadata for label %d: %w", label, err)
+			}
+			cborData, err := cbor.Encode(metadatum)
+			if err != nil {
+				return err
+			}
+			if t.Other == nil {
+				t.Other = make(map[uint64]cbor.RawMessage)
+			}
+			t.Other[label] = cborData
+		}
+	}
+	return nil
+}
+
+func (t TxMetadata) MarshalJSON() ([]byte, error) {
+	tmpMap := make(map[string]any, len(t.Other)+2)
+	for label, val := range t.Other {
+		var tmpVal any
+		if _, err := cbor.Decode(val, &tmpVal); err != nil {
+			return nil, err
+		}
+		tmpMap[strconv.FormatUint(label, 10)] = metadatumToJson(tmpVal)
+	}
+	if t.Cip20 != nil {
+		tmpMap[strconv.Itoa(Cip20MetadataLabel)] = t.Cip20.Num674
+	}
+	if t.Cip27 != nil {
+		tmpMap[strconv.Itoa(Cip27MetadataLabel)] = t.Cip27.Num777
+	}
+	return json.Marshal(tmpMap)
+}
+
+// UnmarshalYAML decodes the metadata map from YAML, using the same fields as UnmarshalJSON
+func (t *TxMetadata) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYamlViaJson(node, t)
+}
+
+// MarshalYAML encodes the metadata map as YAML, using the same fields as MarshalJSON
+func (t TxMetadata) MarshalYAML() (any, error) {
+	return marshalYamlViaJson(t)
+}
+
+func (t *TxMetadata) typedItems() []any {
+	var ret []any
+	if t.Cip20 != nil {
+		ret = append(ret, t.Cip20)
+	}
+	if t.Cip27 != nil {
+		ret = append(ret, t.Cip27)
+	}
+	return ret
+}
+
+// LogValue summarizes the metadata for structured logging as the list of labels present
+func (t TxMetadata) LogValue() slog.Value {
+	labels := make([]uint64, 0, len(t.Other)+2)
+	if t.Cip20 != nil {
+		labels = append(labels, Cip20MetadataLabel)
+	}
+	if t.Cip27 != nil {
+		labels = append(labels, Cip27MetadataLabel)
+	}
+	for label := range t.Other {
+		labels = append(labels, label)
+	}
+	slices.Sort(labels)
+	return slog.GroupValue(
+		slog.Any("labels", labels),
+	)
+}
+
+// Prefix used for byte strings in the JSON representation of metadata, matching the cardano-cli
+// "no schema" JSON format
+const metadataJsonBytesPrefix = "0x"
+
+// jsonToMetadatum converts a value decoded from JSON (using json.Decoder.UseNumber) into a value
+// that encodes to valid transaction metadata. Numbers must be integers that fit in the metadata
+// integer range, since metadata can't contain floats. Strings with a "0x" prefix followed by
+// valid hex are converted to byte strings
+func jsonToMetadatum(val any) (any, error) {
+	switch v := val.(type) {
+	case map[string]any:
+		ret := make(map[string]any, len(v))
+		for key, item := range v {
+			tmpItem, err := jsonToMetadatum(item)
+			if err != nil {
+				return nil, err
+			}
+			ret[key] = tmpItem
+		}
+		return ret, nil
+	case []any:
+		ret := make([]any, len(v))
+		for idx, item := range v {
+			tmpItem, err := jsonToMetadatum(item)
+			if err != nil {
+				return nil, err
+			}
+			ret[idx] = tmpItem
+		}
+		return ret, nil
+	case json.Number:
+		if intVal, err := v.Int64(); err == nil {
+			return intVal, nil
+		}
+		if uintVal, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+			return uintVal, nil
+		}
+		return nil, fmt.Errorf("unsupported number in metadata: %s", v)
+	case string:
+		if hexStr, ok := strings.CutPrefix(v, metadataJsonBytesPrefix); ok {
+			if byteVal, err := hex.DecodeString(hexStr); err == nil {
+				return byteVal, nil
+			}
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unsupported value in metadata: %v", v)
+	}
+}
+
+// metadatumToJson converts a generically decoded metadatum into a value that can be encoded as
+// JSON. Map keys are converted to strings and byte strings are hex encoded with a "0x" prefix, so
+// that jsonToMetadatum converts them back to byte strings
+func metadatumToJson(val any) any {
+	switch v := val.(type) {
+	case map[any]any:
+		ret := make(map[string]any, len(v))
+		for key, item := range v {
+			ret[fmt.Sprint(metadatumToJson(key))] = metadatumToJson(item)
+		}
+		return ret
+	case []any:
+		ret := make([]any, len(v))
+		for idx, item := range v {
+			ret[idx] = metadatumToJson(item)
+		}
+		return ret
+	case []byte:
+		return metadataJsonBytesPrefix + hex.EncodeToString(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestTxMetadataDecodeEncodeCbor(t *testing.T) {
+	testCborHex := "a21902a2a1636d7367816a496e766f6963652034321907afa2646861736842abcd646e616d656474657374"
+	cborData, err := hex.DecodeString(testCborHex)
+	require.NoError(t, err)
+	var testObj models.TxMetadata
+	_, err = cbor.Decode(cborData, &testObj)
+	require.NoError(t, err)
+	require.NotNil(t, testObj.Cip20)
+	require.Equal(t, []string{"Invoice 42"}, testObj.Cip20.Num674.Msg)
+	require.Nil(t, testObj.Cip27)
+	require.Equal(
+		t,
+		map[uint64]cbor.RawMessage{
+			1967: decodeHex("a2646861736842abcd646e616d656474657374"),
+		},
+		testObj.Other,
+	)
+	encoded, err := cbor.Encode(&testObj)
+	require.NoError(t, err)
+	require.Equal(t, testCborHex, hex.EncodeToString(encoded))
+	// Other labels are also exposed via JSON
+	jsonData, err := json.Marshal(&testObj)
+	require.NoError(t, err)
+	require.JSONEq(
+		t,
+		`{"674":{"msg":["Invoice 42"]},"1967":{"hash":"0xabcd","name":"test"}}`,
+		string(jsonData),
+	)
+	// Byte strings keep their type through a round trip via JSON
+	var jsonObj models.TxMetadata
+	require.NoError(t, json.Unmarshal(jsonData, &jsonObj))
+	encoded, err = cbor.Encode(&jsonObj)
+	require.NoError(t, err)
+	require.Equal(t, testCborHex, hex.EncodeToString(encoded))
+}
+
+func TestTxMetadataDecodeEncodeJson(t *testing.T) {
+	testJson := `{"674":{"msg":["Invoice 42"]},"777":{"rate":"0.05","addr":"addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9un"},"1967":{"name":"test"}}`
+	var testObj models.TxMetadata
+	require.NoError(t, json.Unmarshal([]byte(testJson), &testObj))
+	require.NotNil(t, testObj.Cip20)
+	require.Equal(t, []string{"Invoice 42"}, testObj.Cip20.Num674.Msg)
+	require.NotNil(t, testObj.Cip27)
+	require.Equal(t, "0.05", testObj.Cip27.Num777.Rate)
+	require.Equal(t, []string{"addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9un"}, testObj.Cip27.Num777.Addr.Addresses)
+	require.Contains(t, testObj.Other, uint64(1967))
+	jsonData, err := json.Marshal(&testObj)
+	require.NoError(t, err)
+	require.JSONEq(t, testJson, string(jsonData))
+}
+
+func TestTxMetadataInvalidLabel(t *testing.T) {
+	var testObj models.TxMetadata
+	require.Error(t, json.Unmarshal([]byte(`{"foo":{}}`), &testObj))
+}
+
+func TestTxMetadataLogValue(t *testing.T) {
+	txMetadata := models.TxMetadata{
+		Cip20: &models.Cip20Metadata{Num674: models.Num674{Msg: []string{"Invoice 42"}}},
+		Other: map[uint64]cbor.RawMessage{
+			1967: cbor.RawMessage{0xa0},
+			87:   cbor.RawMessage{0x60},
+		},
+	}
+	require.Equal(t, "[labels=[87 674 1967]]", txMetadata.LogValue().String())
+}
+
+func TestTxMetadataDecodeEncodeYaml(t *testing.T) {
+	testYaml := `674:
+  msg:
+    - Invoice 42
+777:
+  rate: "0.05"
+  addr: addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9un
+1967:
+  amount: 1000000
+  name: test
+`
+	var testObj models.TxMetadata
+	require.NoError(t, yaml.Unmarshal([]byte(testYaml), &testObj))
+	require.NotNil(t, testObj.Cip20)
+	require.Equal(t, []string{"Invoice 42"}, testObj.Cip20.Num674.Msg)
+	require.NotNil(t, testObj.Cip27)
+	require.Equal(t, "0.05", testObj.Cip27.Num777.Rate)
+	require.Contains(t, testObj.Other, uint64(1967))
+	yamlData, err := yaml.Marshal(&testObj)
+	require.NoError(t, err)
+	expectedYaml := `"674":
+    msg:
+        - Invoice 42
+"777":
+    addr: addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9un
+    rate: "0.05"
+"1967":
+    amount: 1000000
+    name: test
+`
+	require.Equal(t, expectedYaml, string(yamlData))
+}
+
+func TestTxMetadataJsonIntegers(t *testing.T) {
+	var testObj models.TxMetadata
+	require.NoError(t, json.Unmarshal([]byte(`{"1":42,"2":{"big":18446744073709551615,"neg":[-1]}}`), &testObj))
+	cborData, err := cbor.Encode(&testObj)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"a201182a02a2636269671bffffffffffffffff636e65678120",
+		hex.EncodeToString(cborData),
+	)
+	// Metadata can't contain floats or other non-integer JSON values
+	require.Error(t, json.Unmarshal([]byte(`{"1":1.5}`), &testObj))
+	require.Error(t, json.Unmarshal([]byte(`{"1":true}`), &testObj))
+	require.Error(t, json.Unmarshal([]byte(`{"1":null}`), &testObj))
+}
+
+func TestTxMetadataJsonByteStrings(t *testing.T) {
+	var testObj models.TxMetadata
+	require.NoError(t, json.Unmarshal([]byte(`{"1":["0xabcd","0x","0xzz","abcd"]}`), &testObj))
+	cborData, err := cbor.Encode(&testObj)
+	require.NoError(t, err)
+	// Only strings with the "0x" prefix and valid hex are byte strings
+	require.Equal(
+		t,
+		"a1018442abcd406430787a7a6461626364",
+		hex.EncodeToString(cborData),
+	)
+}
+
+func TestTxMetadataValidatesTypedLabels(t *testing.T) {
+	var testObj models.TxMetadata
+	// Invalid CIP-27 rate
+	require.Error(
+		t,
+		json.Unmarshal(
+			[]byte(`{"777":{"rate":"2","addr":"addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9un"}}`),
+			&testObj,
+		),
+	)
+	// Empty CIP-20 message list
+	require.Error(t, json.Unmarshal([]byte(`{"674":{"msg":[]}}`), &testObj))
+	_, err := models.DecodeHex[models.TxMetadata]("a11902a2a1636d736780")
+	require.Error(t, err)
+}
+
+func TestTxMetadataYamlToCborIntegers(t *testing.T) {
+	testYaml := "1967:\n  amount: 1000000\n  items: [1, -2]\n"
+	var testObj models.TxMetadata
+	require.NoError(t, yaml.Unmarshal([]byte(testYaml), &testObj))
+	cborData, err := cbor.Encode(&testObj)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"a11907afa2656974656d7382012166616d6f756e741a000f4240",
+		hex.EncodeToString(cborData),
+	)
+	// Floats aren't valid metadata
+	require.Error(t, yaml.Unmarshal([]byte("1967:\n  amount: 1.5\n"), &testObj))
+}
+
+func TestTxMetadataYamlAliases(t *testing.T) {
+	testYaml := "1967:\n  a: &x [1, 2]\n  b: *x\n"
+	var testObj models.TxMetadata
+	require.NoError(t, yaml.Unmarshal([]byte(testYaml), &testObj))
+	jsonData, err := json.Marshal(testObj)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"1967":{"a":[1,2],"b":[1,2]}}`, string(jsonData))
+}
+
+func TestTxMetadataYamlAliasBomb(t *testing.T) {
+	// Each level references the previous one 9 times, which would expand to billions of nodes
+	var sb strings.Builder
+	sb.WriteString("1967:\n  a: &a [\"lol\", \"lol\", \"lol\", \"lol\", \"lol\", \"lol\", \"lol\", \"lol\", \"lol\"]\n")
+	prev := "a"
+	for _, name := range []string{"b", "c", "d", "e", "f", "g", "h", "i"} {
+		refs := make([]string, 9)
+		for idx := range refs {
+			refs[idx] = "*" + prev
+		}
+		sb.WriteString("  " + name + ": &" + name + " [" + strings.Join(refs, ", ") + "]\n")
+		prev = name
+	}
+	var testObj models.TxMetadata
+	for _, target := range []any{&testObj, &models.Cip20Metadata{}, &models.Cip27Metadata{}} {
+		err := yaml.Unmarshal([]byte(sb.String()), target)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "YAML aliases")
+	}
+}
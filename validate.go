@@ -0,0 +1,224 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/blinklabs-io/gouroboros/bech32"
+	"github.com/go-playground/validator/v10"
+)
+
+// Custom validation tags registered on the package validator. They can be used by other structs
+// after registering them on a validator with RegisterValidations
+const (
+	// ValidationTagBech32Addr checks for a bech32 Shelley payment or stake address
+	ValidationTagBech32Addr = "bech32addr"
+	// ValidationTagPolicyId checks for a hex-encoded 28-byte policy ID
+	ValidationTagPolicyId = "policyid"
+	// ValidationTagAssetName checks for a hex-encoded asset name of at most 32 bytes
+	ValidationTagAssetName = "assetname"
+	// ValidationTagIpfsUri checks for an ipfs:// URI with a valid CID
+	ValidationTagIpfsUri = "ipfsuri"
+	// ValidationTagMimeType checks for a media type such as image/png
+	ValidationTagMimeType = "mimetype"
+)
+
+var customValidations = map[string]validator.Func{
+	ValidationTagBech32Addr: ValidateBech32Addr,
+	ValidationTagPolicyId:   ValidatePolicyId,
+	ValidationTagAssetName:  ValidateAssetName,
+	ValidationTagIpfsUri:    ValidateIpfsUri,
+	ValidationTagMimeType:   ValidateMimeType,
+	// The "max" validation counts characters for strings, but the metadata limit is in bytes
+	"maxbytes": validateMaxBytes,
+}
+
+// RegisterValidations registers the package's custom validation tags on the provided validator
+func RegisterValidations(validate *validator.Validate) error {
+	for tag, fn := range customValidations {
+		if err := validate.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// packageValidator returns the validator shared by the models, which has the custom validation
+// tags registered
+var packageValidator = sync.OnceValue(func() *validator.Validate {
+	validate := validator.New()
+	if err := RegisterValidations(validate); err != nil {
+		panic(err)
+	}
+	return validate
+})
+
+// ValidateBech32Addr validates a string field containing a bech32 Shelley payment or stake
+// address. The header type, payload length and prefix must all be consistent. An AddrField is
+// validated as its royalty addresses
+func ValidateBech32Addr(fl validator.FieldLevel) bool {
+	if addrField, ok := fl.Field().Interface().(AddrField); ok {
+		for _, addr := range addrField.royaltyAddresses() {
+			if !isBech32Addr(addr) {
+				return false
+			}
+		}
+		return true
+	}
+	if fl.Field().Kind() != reflect.String {
+		return false
+	}
+	return isBech32Addr(fl.Field().String())
+}
+
+func isBech32Addr(addr string) bool {
+	_, err := parseShelleyAddress(addr)
+	return err == nil
+}
+
+// Shelley address header values used when validating addresses
+const (
+	shelleyAddressTypeMask        = 0xf0
+	shelleyAddressNetworkMask     = 0x0f
+	shelleyAddressTypePointer     = 0x40
+	shelleyAddressTypeEnterprise  = 0x60
+	shelleyAddressTypeMaxPayment  = 0x70
+	shelleyAddressTypeStake       = 0xe0
+	shelleyAddressTypeStakeScript = 0xf0
+	shelleyAddressHashSize        = 28
+)
+
+// parseShelleyAddress checks that addr is a structurally valid Shelley bech32 payment or stake
+// address, with a prefix matching its type and network, and returns its header byte
+func parseShelleyAddress(addr string) (byte, error) {
+	hrp, data, err := bech32.DecodeNoLimit(addr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid address %s: %w", addr, err)
+	}
+	addrBytes, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return 0, fmt.Errorf("invalid address %s: %w", addr, err)
+	}
+	if len(addrBytes) == 0 {
+		return 0, fmt.Errorf("invalid address %s: empty payload", addr)
+	}
+	header := addrBytes[0]
+	addrType := header & shelleyAddressTypeMask
+	var expectedHrp string
+	var validLength bool
+	switch {
+	case addrType < shelleyAddressTypePointer:
+		// Base address with payment and stake credentials
+		expectedHrp = "addr"
+		validLength = len(addrBytes) == 1+2*shelleyAddressHashSize
+	case addrType < shelleyAddressTypeEnterprise:
+		// Pointer address with a variable length stake pointer
+		expectedHrp = "addr"
+		validLength = len(addrBytes) > 1+shelleyAddressHashSize
+	case addrType <= shelleyAddressTypeMaxPayment:
+		// Enterprise address with only a payment credential
+		expectedHrp = "addr"
+		validLength = len(addrBytes) == 1+shelleyAddressHashSize
+	case addrType == shelleyAddressTypeStake, addrType == shelleyAddressTypeStakeScript:
+		// Stake (reward) address with only a stake credential
+		expectedHrp = "stake"
+		validLength = len(addrBytes) == 1+shelleyAddressHashSize
+	default:
+		return 0, fmt.Errorf("invalid address %s: unsupported address type", addr)
+	}
+	if header&shelleyAddressNetworkMask != 1 {
+		expectedHrp += "_test"
+	}
+	if hrp != expectedHrp {
+		return 0, fmt.Errorf(
+			"invalid address %s: prefix %q does not match network",
+			addr,
+			hrp,
+		)
+	}
+	if !validLength {
+		return 0, fmt.Errorf(
+			"invalid address %s: unexpected length %d",
+			addr,
+			len(addrBytes),
+		)
+	}
+	return header, nil
+}
+
+// ValidatePolicyId validates a hex string field or byte slice field containing a policy ID
+func ValidatePolicyId(fl validator.FieldLevel) bool {
+	data, ok := validationFieldBytes(fl)
+	return ok && len(data) == 28
+}
+
+// ValidateAssetName validates a hex string field or byte slice field containing an asset name
+func ValidateAssetName(fl validator.FieldLevel) bool {
+	data, ok := validationFieldBytes(fl)
+	return ok && len(data) <= 32
+}
+
+// validationFieldBytes returns the bytes of a byte slice field or the decoded value of a hex
+// string field
+func validationFieldBytes(fl validator.FieldLevel) ([]byte, bool) {
+	field := fl.Field()
+	switch {
+	case field.Kind() == reflect.String:
+		data, err := hex.DecodeString(field.String())
+		return data, err == nil
+	case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Uint8:
+		return field.Bytes(), true
+	default:
+		return nil, false
+	}
+}
+
+// IPFS content IDs, either a base58 CIDv0 or a base32 CIDv1
+var ipfsCidRegexp = regexp.MustCompile(`^(Qm[1-9A-HJ-NP-Za-km-z]{44}|b[a-z2-7]{58,})$`)
+
+// ValidateIpfsUri validates a string field containing an ipfs:// URI, optionally with a path
+// after the CID. The legacy ipfs://ipfs/<cid> form is also accepted
+func ValidateIpfsUri(fl validator.FieldLevel) bool {
+	if fl.Field().Kind() != reflect.String {
+		return false
+	}
+	uri, ok := strings.CutPrefix(fl.Field().String(), "ipfs://")
+	if !ok {
+		return false
+	}
+	uri = strings.TrimPrefix(uri, "ipfs/")
+	cid, _, _ := strings.Cut(uri, "/")
+	return ipfsCidRegexp.MatchString(cid)
+}
+
+// ValidateMimeType validates a string field containing a media type, with optional parameters
+func ValidateMimeType(fl validator.FieldLevel) bool {
+	if fl.Field().Kind() != reflect.String {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(fl.Field().String())
+	if err != nil {
+		return false
+	}
+	mainType, subType, ok := strings.Cut(mediaType, "/")
+	return ok && mainType != "" && subType != ""
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// testValidationAsset is a downstream struct using the package's validation tags
+type testValidationAsset struct {
+	Owner     string `validate:"bech32addr"`
+	PolicyId  string `validate:"policyid"`
+	AssetName []byte `validate:"assetname"`
+	Image     string `validate:"ipfsuri"`
+	MediaType string `validate:"mimetype"`
+}
+
+func TestRegisterValidations(t *testing.T) {
+	validate := validator.New()
+	if err := models.RegisterValidations(validate); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	validAsset := testValidationAsset{
+		Owner:     "addr1v9nevxg9wunfck0gt7hpxuy0elnqygglme3u6l3nn5q5gnq5dc9un",
+		PolicyId:  "f0ff48bbb7bbe9d59a40f1ce90e9e9d0ff5002ec48f232b49ca0fb9a",
+		AssetName: []byte("TUNA"),
+		Image:     "ipfs://QmSwbR6LNhkaUyhFKwk6BnHdJk4gxdvdfknGhoGgywUDUN/1.png",
+		MediaType: "image/png",
+	}
+	if err := validate.Struct(validAsset); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Stake addresses are also accepted
+	stakeAsset := validAsset
+	stakeAsset.Owner = "stake1uyqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qpxs5mw"
+	if err := validate.Struct(stakeAsset); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	testDefs := []struct {
+		name   string
+		modify func(*testValidationAsset)
+	}{
+		{
+			name:   "bad address prefix",
+			modify: func(a *testValidationAsset) { a.Owner = "pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy" },
+		},
+		{
+			name:   "short address payload",
+			modify: func(a *testValidationAsset) { a.Owner = "addr1vyqsyqcyq5rqwzqfpg9scrgwpugpzysnzs0wy3dx" },
+		},
+		{
+			name:   "unsupported address header",
+			modify: func(a *testValidationAsset) { a.Owner = "addr1syqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qc8ujju" },
+		},
+		{
+			name:   "address prefix network mismatch",
+			modify: func(a *testValidationAsset) { a.Owner = "addr1vqqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qa7qr8m" },
+		},
+		{
+			name:   "stake address with payment prefix",
+			modify: func(a *testValidationAsset) { a.Owner = "addr1uyqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8q87xjmu" },
+		},
+		{
+			name:   "short policy ID",
+			modify: func(a *testValidationAsset) { a.PolicyId = "f0ff48bb" },
+		},
+		{
+			name:   "long asset name",
+			modify: func(a *testValidationAsset) { a.AssetName = make([]byte, 33) },
+		},
+		{
+			name:   "HTTP image",
+			modify: func(a *testValidationAsset) { a.Image = "https://example.com/1.png" },
+		},
+		{
+			name:   "bad CID",
+			modify: func(a *testValidationAsset) { a.Image = "ipfs://notacid" },
+		},
+		{
+			name:   "bad media type",
+			modify: func(a *testValidationAsset) { a.MediaType = "png" },
+		},
+	}
+	for _, testDef := range testDefs {
+		t.Run(testDef.name, func(t *testing.T) {
+			asset := validAsset
+			testDef.modify(&asset)
+			if err := validate.Struct(asset); err == nil {
+				t.Fatalf("did not get expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"gopkg.in/yaml.v3"
+)
+
+// WanchainMetadataLabel is the transaction metadata label used by the Wanchain bridge ("WAN")
+const WanchainMetadataLabel = 5718350
+
+// WanchainMetadataType identifies the bridge operation that a Wanchain metadata marker describes
+type WanchainMetadataType uint
+
+const (
+	WanchainMetadataTypeUserLock   WanchainMetadataType = 1
+	WanchainMetadataTypeSmgRelease WanchainMetadataType = 2
+	WanchainMetadataTypeUserBurn   WanchainMetadataType = 3
+	WanchainMetadataTypeSmgMint    WanchainMetadataType = 4
+)
+
+// WanchainMetadata is the top-level container for Wanchain bridge metadata
+type WanchainMetadata struct {
+	Num5718350 WanchainBridgeMetadata `cbor:"5718350,keyasint" json:"5718350" validate:"required"`
+}
+
+// WanchainBridgeMetadata describes a cross-chain transfer through the Wanchain bridge
+type WanchainBridgeMetadata struct {
+	Type        WanchainMetadataType `cbor:"type" json:"type" validate:"required,min=1,max=4"`
+	TokenPairId uint64               `cbor:"tokenPairID" json:"tokenPairID"`
+	// ToAccount is the destination account on the other chain, for lock and burn operations
+	ToAccount string `cbor:"toAccount,omitempty" json:"toAccount,omitempty" validate:"max=64"`
+	// UniqueId is the hex-encoded ID of the source transaction, for release and mint operations
+	UniqueId string `cbor:"uniqueId,omitempty" json:"uniqueId,omitempty" validate:"max=64"`
+}
+
+// UnmarshalYAML decodes the metadata from YAML, using the same fields as UnmarshalJSON
+func (w *WanchainMetadata) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYamlViaJson(node, w)
+}
+
+// MarshalYAML encodes the metadata as YAML, using the same fields as MarshalJSON
+func (w WanchainMetadata) MarshalYAML() (any, error) {
+	return marshalYamlViaJson(w)
+}
+
+// LogValue summarizes the metadata for structured logging
+func (w WanchainMetadata) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Uint64("type", uint64(w.Num5718350.Type)),
+		slog.Uint64("tokenPairId", w.Num5718350.TokenPairId),
+	)
+}
+
+func (w *WanchainMetadata) Validate() error {
+	if w.Num5718350 == (WanchainBridgeMetadata{}) {
+		return fmt.Errorf("%w %d in Wanchain metadata", ErrMissingLabel, WanchainMetadataLabel)
+	}
+	return packageValidator().Struct(w)
+}
+
+// WanchainLockDatum represents the datum attached to assets locked in the Wanchain bridge
+// contract by a user
+type WanchainLockDatum struct {
+	// StoremanGroupId identifies the storeman group handling the transfer
+	StoremanGroupId []byte
+	TokenPairId     int64
+	// ToAccount is the raw destination account on the other chain
+	ToAccount []byte
+	Amount    int64
+}
+
+func (w *WanchainLockDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			w.StoremanGroupId,
+			w.TokenPairId,
+			w.ToAccount,
+			w.Amount,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (w *WanchainLockDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 4)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(
+		fields,
+		&w.StoremanGroupId,
+		&w.TokenPairId,
+		&w.ToAccount,
+		&w.Amount,
+	)
+}
+
+// WanchainReleaseDatum represents the datum used by the Wanchain storeman group when releasing
+// or minting assets on Cardano for a transfer from another chain
+type WanchainReleaseDatum struct {
+	// UniqueId is the ID of the source transaction on the other chain
+	UniqueId    []byte
+	TokenPairId int64
+	Recipient   PlutusAddress
+	Amount      int64
+	// Ttl is the POSIX time (in milliseconds) after which the release is no longer valid
+	Ttl int64
+}
+
+func (w *WanchainReleaseDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			w.UniqueId,
+			w.TokenPairId,
+			&w.Recipient,
+			w.Amount,
+			w.Ttl,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (w *WanchainReleaseDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 5)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(
+		fields,
+		&w.UniqueId,
+		&w.TokenPairId,
+		&w.Recipient,
+		&w.Amount,
+		&w.Ttl,
+	)
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWanchainLockDatumDecodeEncode(t *testing.T) {
+	testCborHex := "d8799f5820000000000000000000000000000000000000000000746573746e65745f3035380e548f3cf7ad23cd3cadbd9735aff958023239c6a0631a02faf080ff"
+	expectedObj := models.WanchainLockDatum{
+		StoremanGroupId: decodeHex("000000000000000000000000000000000000000000746573746e65745f303538"),
+		TokenPairId:     14,
+		ToAccount:       decodeHex("8f3cf7ad23cd3cadbd9735aff958023239c6a063"),
+		Amount:          50000000,
+	}
+	var testObj models.WanchainLockDatum
+	testDecodeEncode(t, testCborHex, &testObj, &expectedObj)
+}
+
+func TestWanchainReleaseDatumDecodeEncode(t *testing.T) {
+	testCborHex := "d8799f5820eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee0ed8799fd8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5ffd8799fd8799fd8799f581c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809ffffffff1a02faf0801b000001941f606a80ff"
+	expectedObj := models.WanchainReleaseDatum{
+		UniqueId:    decodeHex("eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"),
+		TokenPairId: 14,
+		Recipient:   testPlutusAddressBase,
+		Amount:      50000000,
+		Ttl:         1735693200000,
+	}
+	var testObj models.WanchainReleaseDatum
+	testDecodeEncode(t, testCborHex, &testObj, &expectedObj)
+}
+
+func TestWanchainMetadataDecodeEncode(t *testing.T) {
+	testCborHex := "a11a0057414ea364747970650169746f4163636f756e74782a3078386633636637616432336364336361646264393733356166663935383032333233396336613036336b746f6b656e5061697249440e"
+	expectedObj := models.WanchainMetadata{
+		Num5718350: models.WanchainBridgeMetadata{
+			Type:        models.WanchainMetadataTypeUserLock,
+			TokenPairId: 14,
+			ToAccount:   "0x8f3cf7ad23cd3cadbd9735aff958023239c6a063",
+		},
+	}
+	cborData, err := hex.DecodeString(testCborHex)
+	require.NoError(t, err)
+	var testObj models.WanchainMetadata
+	_, err = cbor.Decode(cborData, &testObj)
+	require.NoError(t, err)
+	require.Equal(t, expectedObj, testObj)
+	require.NoError(t, testObj.Validate())
+	encoded, err := cbor.Encode(&testObj)
+	require.NoError(t, err)
+	require.Equal(t, testCborHex, hex.EncodeToString(encoded))
+}
+
+func TestWanchainMetadataValidate(t *testing.T) {
+	testObj := models.WanchainMetadata{
+		Num5718350: models.WanchainBridgeMetadata{
+			Type:        5,
+			TokenPairId: 14,
+		},
+	}
+	require.Error(t, testObj.Validate())
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// WayupPayout represents a lovelace payout required by a Wayup listing
+type WayupPayout struct {
+	Address        PlutusAddress
+	AmountLovelace int64
+}
+
+func (w *WayupPayout) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			&w.Address,
+			w.AmountLovelace,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (w *WayupPayout) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &w.Address, &w.AmountLovelace)
+}
+
+// WayupListingDatum represents the datum format used by Wayup (formerly Genius X) marketplace
+// listings
+type WayupListingDatum struct {
+	// Owner is the public key hash that is allowed to cancel or update the listing
+	Owner   []byte
+	Payouts []WayupPayout
+}
+
+func (w *WayupListingDatum) MarshalCBOR() ([]byte, error) {
+	var payouts any = []any{}
+	if len(w.Payouts) > 0 {
+		tmpList := make(cbor.IndefLengthList, 0, len(w.Payouts))
+		for idx := range w.Payouts {
+			tmpList = append(tmpList, &w.Payouts[idx])
+		}
+		payouts = tmpList
+	}
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			w.Owner,
+			payouts,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (w *WayupListingDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	return decodePlutusFields(fields, &w.Owner, &w.Payouts)
+}
+
+// Price returns the total lovelace paid out by the listing
+func (w WayupListingDatum) Price() int64 {
+	var ret int64
+	for _, payout := range w.Payouts {
+		ret += payout.AmountLovelace
+	}
+	return ret
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+func TestWayupListingDatumDecodeEncode(t *testing.T) {
+	cborHex := "d8799f581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c59fd8799f" + testPlutusAddressBaseHex + "1a02dc6c00ffd8799fd8799fd87a9f581ca65ca58a4e9c755fa830173d2a5caed458ac0c73f97db7faae2e7e3bffd87a80ff1a001e8480ffffff"
+	expectedObj := models.WayupListingDatum{
+		Owner: decodeHex("1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5"),
+		Payouts: []models.WayupPayout{
+			{
+				Address:        testPlutusAddressBase,
+				AmountLovelace: 48000000,
+			},
+			{
+				Address:        testPlutusAddressScript,
+				AmountLovelace: 2000000,
+			},
+		},
+	}
+	var testObj models.WayupListingDatum
+	testDecodeEncode(t, cborHex, &testObj, &expectedObj)
+	if testObj.Price() != 50000000 {
+		t.Fatalf("did not get expected price: got %d", testObj.Price())
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+)
+
+// WingRidersPoolDatum represents the datum format used by the WingRiders liquidity pool contract
+type WingRidersPoolDatum struct {
+	// Hash of the request validator that the pool accepts requests from
+	RequestValidatorHash []byte
+	AssetA               PlutusAssetClass
+	AssetB               PlutusAssetClass
+	// LastInteraction is the POSIX time (in milliseconds) of the last pool interaction
+	LastInteraction int64
+	// Protocol fees held in the pool UTxO which are not part of the reserves
+	TreasuryA int64
+	TreasuryB int64
+}
+
+func (w *WingRidersPoolDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			w.RequestValidatorHash,
+			cbor.NewConstructor(
+				0,
+				cbor.IndefLengthList{
+					&w.AssetA,
+					&w.AssetB,
+					w.LastInteraction,
+					w.TreasuryA,
+					w.TreasuryB,
+				},
+			),
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (w *WingRidersPoolDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	if _, err := cbor.Decode(fields[0], &w.RequestValidatorHash); err != nil {
+		return err
+	}
+	poolConstr, poolFields, err := decodePlutusConstr(fields[1], 5)
+	if err != nil {
+		return err
+	}
+	if poolConstr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, poolConstr)
+	}
+	return decodePlutusFields(
+		poolFields,
+		&w.AssetA,
+		&w.AssetB,
+		&w.LastInteraction,
+		&w.TreasuryA,
+		&w.TreasuryB,
+	)
+}
+
+// Reserves returns the pool reserves of asset A and asset B from the pool UTxO value, excluding
+// the treasury amounts
+func (w WingRidersPoolDatum) Reserves(value PlutusValue) (int64, int64) {
+	return value.AssetAmount(w.AssetA) - w.TreasuryA, value.AssetAmount(w.AssetB) - w.TreasuryB
+}
+
+// WingRidersSwapDirection identifies which way a WingRiders swap request trades
+type WingRidersSwapDirection uint
+
+const (
+	WingRidersSwapAToB WingRidersSwapDirection = 0
+	WingRidersSwapBToA WingRidersSwapDirection = 1
+)
+
+// WingRidersRequestActionType identifies the type of a WingRiders request
+type WingRidersRequestActionType uint
+
+const (
+	WingRidersRequestActionSwap            WingRidersRequestActionType = 0
+	WingRidersRequestActionAddLiquidity    WingRidersRequestActionType = 1
+	WingRidersRequestActionRemoveLiquidity WingRidersRequestActionType = 2
+)
+
+// WingRidersRequestAction represents the action requested by a WingRiders request. Only the
+// fields relevant to the action type are used
+type WingRidersRequestAction struct {
+	Type WingRidersRequestActionType
+	// Used by Swap
+	Direction       WingRidersSwapDirection
+	MinWantedTokens int64
+	// Used by AddLiquidity
+	MinWantedShares int64
+	// Used by RemoveLiquidity
+	MinWantedA int64
+	MinWantedB int64
+}
+
+func (w *WingRidersRequestAction) MarshalCBOR() ([]byte, error) {
+	var fields cbor.IndefLengthList
+	switch w.Type {
+	case WingRidersRequestActionSwap:
+		fields = cbor.IndefLengthList{
+			cbor.NewConstructor(uint(w.Direction), []any{}),
+			w.MinWantedTokens,
+		}
+	case WingRidersRequestActionAddLiquidity:
+		fields = cbor.IndefLengthList{w.MinWantedShares}
+	case WingRidersRequestActionRemoveLiquidity:
+		fields = cbor.IndefLengthList{w.MinWantedA, w.MinWantedB}
+	default:
+		return nil, fmt.Errorf("unknown request action type: %d", w.Type)
+	}
+	tmp := cbor.NewConstructor(uint(w.Type), fields)
+	return cbor.Encode(&tmp)
+}
+
+func (w *WingRidersRequestAction) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, -1)
+	if err != nil {
+		return err
+	}
+	*w = WingRidersRequestAction{
+		Type: WingRidersRequestActionType(constr),
+	}
+	switch w.Type {
+	case WingRidersRequestActionSwap:
+		var tmpDirection cbor.RawMessage
+		if err := decodePlutusFields(fields, &tmpDirection, &w.MinWantedTokens); err != nil {
+			return err
+		}
+		directionConstr, _, err := decodePlutusConstr(tmpDirection, 0)
+		if err != nil {
+			return err
+		}
+		switch WingRidersSwapDirection(directionConstr) {
+		case WingRidersSwapAToB, WingRidersSwapBToA:
+			w.Direction = WingRidersSwapDirection(directionConstr)
+		default:
+			return fmt.Errorf("%w: swap direction %d", ErrUnexpectedConstructor, directionConstr)
+		}
+		return nil
+	case WingRidersRequestActionAddLiquidity:
+		return decodePlutusFields(fields, &w.MinWantedShares)
+	case WingRidersRequestActionRemoveLiquidity:
+		return decodePlutusFields(fields, &w.MinWantedA, &w.MinWantedB)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+}
+
+// WingRidersRequestDatum represents the datum format used by the WingRiders request contract
+type WingRidersRequestDatum struct {
+	// Beneficiary is the address that receives the output of the request
+	Beneficiary PlutusAddress
+	// Owner is the public key hash that is allowed to reclaim the request
+	Owner []byte
+	// Deadline is the POSIX time (in milliseconds) after which the request can no longer be applied
+	Deadline int64
+	AssetA   PlutusAssetClass
+	AssetB   PlutusAssetClass
+	Action   WingRidersRequestAction
+}
+
+func (w *WingRidersRequestDatum) MarshalCBOR() ([]byte, error) {
+	tmp := cbor.NewConstructor(
+		0,
+		cbor.IndefLengthList{
+			cbor.NewConstructor(
+				0,
+				cbor.IndefLengthList{
+					&w.Beneficiary,
+					w.Owner,
+					w.Deadline,
+					&w.AssetA,
+					&w.AssetB,
+				},
+			),
+			&w.Action,
+		},
+	)
+	return cbor.Encode(&tmp)
+}
+
+func (w *WingRidersRequestDatum) UnmarshalCBOR(cborData []byte) error {
+	constr, fields, err := decodePlutusConstr(cborData, 2)
+	if err != nil {
+		return err
+	}
+	if constr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, constr)
+	}
+	metaConstr, metaFields, err := decodePlutusConstr(fields[0], 5)
+	if err != nil {
+		return err
+	}
+	if metaConstr != 0 {
+		return fmt.Errorf("%w: %d", ErrUnexpectedConstructor, metaConstr)
+	}
+	if err := decodePlutusFields(
+		metaFields,
+		&w.Beneficiary,
+		&w.Owner,
+		&w.Deadline,
+		&w.AssetA,
+		&w.AssetB,
+	); err != nil {
+		return err
+	}
+	if _, err := cbor.Decode(fields[1], &w.Action); err != nil {
+		return err
+	}
+	return nil
+}
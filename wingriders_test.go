@@ -0,0 +1,133 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models_test
+
+import (
+	"errors"
+	"testing"
+
+	models "github.com/blinklabs-io/cardano-models"
+)
+
+var testWingRidersAsset = models.PlutusAssetClass{
+	PolicyId:  decodeHex("c0ee29a85b13209423b10447d3c2e6a50641a15c57770e27cb9d5073"),
+	AssetName: []byte("WingRidersToken"),
+}
+
+func TestWingRidersPoolDatumDecodeEncode(t *testing.T) {
+	testDefs := []struct {
+		cborHex     string
+		expectedObj models.WingRidersPoolDatum
+	}{
+		{
+			cborHex: "d8799f581c86ae9eebd8b97944a45201e4aec1330a72291af2d071644bba015959d8799fd8799f4040ffd8799f581cc0ee29a85b13209423b10447d3c2e6a50641a15c57770e27cb9d50734f57696e67526964657273546f6b656eff1b000001941f297c001a0016e3601909c4ffff",
+			expectedObj: models.WingRidersPoolDatum{
+				RequestValidatorHash: decodeHex("86ae9eebd8b97944a45201e4aec1330a72291af2d071644bba015959"),
+				AssetA:               testLovelaceAsset,
+				AssetB:               testWingRidersAsset,
+				LastInteraction:      1735689600000,
+				TreasuryA:            1500000,
+				TreasuryB:            2500,
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		var testObj models.WingRidersPoolDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &testDef.expectedObj)
+	}
+}
+
+func TestWingRidersPoolDatumReserves(t *testing.T) {
+	datum := models.WingRidersPoolDatum{
+		AssetA:    testLovelaceAsset,
+		AssetB:    testWingRidersAsset,
+		TreasuryA: 1500000,
+		TreasuryB: 2500,
+	}
+	value := models.PlutusValue{
+		"": {"": 101500000},
+		string(testWingRidersAsset.PolicyId): {
+			string(testWingRidersAsset.AssetName): 502500,
+		},
+	}
+	reserveA, reserveB := datum.Reserves(value)
+	if reserveA != 100000000 || reserveB != 500000 {
+		t.Fatalf("did not get expected reserves: got %d/%d", reserveA, reserveB)
+	}
+}
+
+func TestWingRidersRequestDatumDecodeEncode(t *testing.T) {
+	requestPrefixHex := "d8799fd8799f" + testPlutusAddressBaseHex + "581c1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c51b000001941f606a80d8799f4040ffd8799f581cc0ee29a85b13209423b10447d3c2e6a50641a15c57770e27cb9d50734f57696e67526964657273546f6b656effff"
+	testDefs := []struct {
+		cborHex string
+		action  models.WingRidersRequestAction
+	}{
+		{
+			// Swap B to A
+			cborHex: requestPrefixHex + "d8799fd87a801a000f1206ffff",
+			action: models.WingRidersRequestAction{
+				Type:            models.WingRidersRequestActionSwap,
+				Direction:       models.WingRidersSwapBToA,
+				MinWantedTokens: 987654,
+			},
+		},
+		{
+			// Add liquidity
+			cborHex: requestPrefixHex + "d87a9f1a0001e240ffff",
+			action: models.WingRidersRequestAction{
+				Type:            models.WingRidersRequestActionAddLiquidity,
+				MinWantedShares: 123456,
+			},
+		},
+		{
+			// Remove liquidity
+			cborHex: requestPrefixHex + "d87b9f1a000f42401907d0ffff",
+			action: models.WingRidersRequestAction{
+				Type:       models.WingRidersRequestActionRemoveLiquidity,
+				MinWantedA: 1000000,
+				MinWantedB: 2000,
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		expectedObj := models.WingRidersRequestDatum{
+			Beneficiary: testPlutusAddressBase,
+			Owner:       decodeHex("1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5"),
+			Deadline:    1735693200000,
+			AssetA:      testLovelaceAsset,
+			AssetB:      testWingRidersAsset,
+			Action:      testDef.action,
+		}
+		var testObj models.WingRidersRequestDatum
+		testDecodeEncode(t, testDef.cborHex, &testObj, &expectedObj)
+	}
+}
+
+func TestWingRidersRequestActionInvalid(t *testing.T) {
+	for _, cborHex := range []string{
+		// Unknown swap direction
+		"d8799fd87b801a000f1206ff",
+		// Unknown action
+		"d87c80",
+	} {
+		_, err := models.DecodeHex[models.WingRidersRequestAction](cborHex)
+		if !errors.Is(err, models.ErrUnexpectedConstructor) {
+			t.Fatalf("did not get expected error decoding %s: %v", cborHex, err)
+		}
+	}
+	if _, err := models.DecodeHex[models.WingRidersRequestAction]("d8799fd865801a000f1206ff"); err == nil {
+		t.Fatalf("did not get expected error")
+	}
+}
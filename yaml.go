@@ -0,0 +1,181 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The YAML support for the metadata models reuses their JSON encoding, so that both formats
+// accept and produce the same fields. Conversion goes through YAML nodes rather than generic
+// values so that numbers keep their exact textual form and integer map keys (such as metadata
+// labels) are treated the same as their quoted JSON equivalents
+
+// maxYamlAliasNodes limits the number of nodes that can be produced by expanding YAML aliases.
+// The conversion walks aliases by hand, which bypasses the yaml.v3 guard against excessive
+// aliasing, so without a limit a small document could expand to an enormous value
+const maxYamlAliasNodes = 10000
+
+// unmarshalYamlViaJson converts a YAML node to JSON and decodes it into dest
+func unmarshalYamlViaJson(node *yaml.Node, dest any) error {
+	conv := &yamlJsonConverter{}
+	tmpVal, err := conv.convert(node, false)
+	if err != nil {
+		return err
+	}
+	jsonData, err := json.Marshal(tmpVal)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonData, dest)
+}
+
+// marshalYamlViaJson encodes src as JSON and converts the result to a YAML node
+func marshalYamlViaJson(src any) (*yaml.Node, error) {
+	jsonData, err := json.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(jsonData))
+	dec.UseNumber()
+	var tmpVal any
+	if err := dec.Decode(&tmpVal); err != nil {
+		return nil, err
+	}
+	return jsonToYamlNode(tmpVal), nil
+}
+
+// yamlJsonConverter converts YAML nodes to generic JSON values, keeping track of how many nodes
+// have been expanded from aliases
+type yamlJsonConverter struct {
+	aliasNodes int
+}
+
+func (c *yamlJsonConverter) convert(node *yaml.Node, inAlias bool) (any, error) {
+	if inAlias {
+		c.aliasNodes++
+		if c.aliasNodes > maxYamlAliasNodes {
+			return nil, fmt.Errorf("YAML aliases expand to more than %d nodes", maxYamlAliasNodes)
+		}
+	}
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return c.convert(node.Content[0], inAlias)
+	case yaml.AliasNode:
+		return c.convert(node.Alias, true)
+	case yaml.MappingNode:
+		ret := make(map[string]any, len(node.Content)/2)
+		for idx := 0; idx+1 < len(node.Content); idx += 2 {
+			keyNode := node.Content[idx]
+			if keyNode.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("unsupported YAML map key at line %d", keyNode.Line)
+			}
+			val, err := c.convert(node.Content[idx+1], inAlias)
+			if err != nil {
+				return nil, err
+			}
+			ret[keyNode.Value] = val
+		}
+		return ret, nil
+	case yaml.SequenceNode:
+		ret := make([]any, 0, len(node.Content))
+		for _, item := range node.Content {
+			val, err := c.convert(item, inAlias)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, val)
+		}
+		return ret, nil
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!str":
+			return node.Value, nil
+		case "!!null":
+			return nil, nil
+		case "!!int", "!!float":
+			// Use the literal when it's already a valid JSON number, to avoid losing precision
+			if json.Valid([]byte(node.Value)) {
+				return json.Number(node.Value), nil
+			}
+		}
+		var ret any
+		if err := node.Decode(&ret); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("unsupported YAML node kind at line %d", node.Line)
+	}
+}
+
+func jsonToYamlNode(val any) *yaml.Node {
+	switch v := val.(type) {
+	case map[string]any:
+		ret := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		// Numeric keys, such as metadata labels, are sorted by value ahead of any other keys
+		sort.Slice(keys, func(i, j int) bool {
+			iNum, iErr := strconv.ParseUint(keys[i], 10, 64)
+			jNum, jErr := strconv.ParseUint(keys[j], 10, 64)
+			if iErr == nil && jErr == nil {
+				return iNum < jNum
+			}
+			if (iErr == nil) != (jErr == nil) {
+				return iErr == nil
+			}
+			return keys[i] < keys[j]
+		})
+		for _, key := range keys {
+			ret.Content = append(
+				ret.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+				jsonToYamlNode(v[key]),
+			)
+		}
+		return ret
+	case []any:
+		ret := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, item := range v {
+			ret.Content = append(ret.Content, jsonToYamlNode(item))
+		}
+		return ret
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(v.String(), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: v.String()}
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: fmt.Sprint(v)}
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+	}
+}